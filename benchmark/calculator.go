@@ -36,3 +36,39 @@ func CalculateIndicators(klines []market.Kline) *IndicatorResult {
 
 	return result
 }
+
+// CalculateIndicatorSeries is CalculateIndicators' sibling: instead of only
+// the final reading of each indicator, it returns the full trajectory so
+// historical offsets can be read back via IndicatorSeriesSet.At/series
+// Last.
+func CalculateIndicatorSeries(klines []market.Kline) *IndicatorSeriesSet {
+	series := &IndicatorSeriesSet{}
+
+	if len(klines) >= 20 {
+		series.MA20 = market.CalculateSMASeries(klines, 20)
+	}
+
+	if len(klines) >= 12 {
+		series.EMA12 = market.CalculateEMASeries(klines, 12)
+	}
+
+	if len(klines) >= 26 {
+		series.EMA26 = market.CalculateEMASeries(klines, 26)
+		series.MACD = market.CalculateMACDSeries(klines)
+	}
+
+	if len(klines) > 14 {
+		series.RSI14 = market.CalculateRSISeries(klines, 14)
+		series.ATR14 = market.CalculateATRSeries(klines, 14)
+	}
+
+	if len(klines) >= 20 {
+		series.BOLLUp, series.BOLLMid, series.BOLLLow = market.CalculateBOLLSeries(klines, 20, 2.0)
+	}
+
+	if len(klines) >= 5 {
+		series.VolumeMA = market.CalculateVolumeMASeries(klines, 5)
+	}
+
+	return series
+}
@@ -0,0 +1,69 @@
+// Package conformance loads a versioned corpus of curated kline snapshots
+// paired with expected indicator values, so benchmark runs can be graded
+// against fixed ground truth instead of recomputing it locally. This
+// decouples "is the implementation correct?" from "does live market data
+// happen to be easy today?" and makes reports reproducible across machines
+// and FinBench releases.
+package conformance
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"FinBench/market"
+)
+
+// Manifest describes a versioned corpus of test vectors, normally checked
+// out via a git submodule pointer (e.g. datasets/vectors/).
+type Manifest struct {
+	CorpusVersion string   `json:"corpus_version"`
+	Vectors       []Vector `json:"vectors"`
+}
+
+// Vector is a single curated snapshot paired with its expected indicator
+// values. Expected is kept as raw JSON so this package stays independent of
+// the benchmark package's IndicatorResult type; callers unmarshal it into
+// whatever shape they grade against.
+type Vector struct {
+	ID            string          `json:"id"`
+	Symbol        string          `json:"symbol"`
+	Interval      string          `json:"interval"`
+	KlineCount    int             `json:"kline_count"`
+	CorpusVersion string          `json:"corpus_version"`
+	Klines        []market.Kline  `json:"klines"`
+	Expected      json.RawMessage `json:"expected"`
+}
+
+// LoadManifest loads and validates a vectors.json manifest from dir.
+func LoadManifest(dir string) (*Manifest, error) {
+	path := filepath.Join(dir, "vectors.json")
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read manifest: %w", err)
+	}
+
+	var manifest Manifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("unmarshal manifest: %w", err)
+	}
+
+	if manifest.CorpusVersion == "" {
+		return nil, fmt.Errorf("manifest missing corpus_version")
+	}
+
+	for _, v := range manifest.Vectors {
+		if v.ID == "" {
+			return nil, fmt.Errorf("manifest vector missing id")
+		}
+		if len(v.Expected) == 0 {
+			return nil, fmt.Errorf("vector %s missing expected block", v.ID)
+		}
+		if len(v.Klines) != v.KlineCount {
+			return nil, fmt.Errorf("vector %s: kline_count=%d but got %d klines", v.ID, v.KlineCount, len(v.Klines))
+		}
+	}
+
+	return &manifest, nil
+}
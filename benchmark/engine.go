@@ -2,21 +2,27 @@ package benchmark
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"math"
 	"runtime"
 	"sort"
+	"strings"
 	"sync"
 	"time"
 
+	"FinBench/benchmark/conformance"
+	"FinBench/llm/cache"
 	"FinBench/logger"
+	"FinBench/market"
 )
 
 const Version = "1.0.0"
 
 // Engine is the main benchmark orchestrator
 type Engine struct {
-	config *BenchConfig
+	config     *BenchConfig
+	cacheStore *cache.Store
 }
 
 // NewEngine creates a new benchmark engine
@@ -27,8 +33,37 @@ func NewEngine(config *BenchConfig) *Engine {
 	return &Engine{config: config}
 }
 
+// cache returns the Engine's lazily-opened llm/cache.Store, or nil if
+// CacheMode is "off" or unset.
+func (e *Engine) cache() (*cache.Store, error) {
+	if e.config.CacheMode == "" || e.config.CacheMode == string(cache.ModeOff) {
+		return nil, nil
+	}
+	if e.cacheStore != nil {
+		return e.cacheStore, nil
+	}
+
+	dir := e.config.CacheDir
+	if dir == "" {
+		dir = cache.DefaultDir()
+	}
+	store, err := cache.NewStore(dir, e.config.CacheMaxBytes)
+	if err != nil {
+		return nil, err
+	}
+	e.cacheStore = store
+	return store, nil
+}
+
 // Run executes the benchmark
 func (e *Engine) Run(ctx context.Context) (*BenchReport, error) {
+	if e.config.MetricsAddr != "" {
+		serveMetrics(e.config.MetricsAddr)
+	}
+
+	stopCPUProfile := startCPUProfile(e.config.CPUProfilePath)
+	defer stopCPUProfile()
+
 	report := &BenchReport{
 		ID:        time.Now().Format("20060102_150405"),
 		Version:   Version,
@@ -47,7 +82,36 @@ func (e *Engine) Run(ctx context.Context) (*BenchReport, error) {
 	var snapshots []*Snapshot
 	var err error
 
-	if e.config.Mode == "static" {
+	// expectedOverrides holds ground truth pulled from a conformance corpus
+	// manifest instead of recomputed via CalculateIndicators.
+	var expectedOverrides map[string]*IndicatorResult
+
+	if e.config.Mode == "conformance" {
+		logger.Infof("Loading conformance corpus from %s", e.config.DatasetDir)
+		manifest, err := conformance.LoadManifest(e.config.DatasetDir)
+		if err != nil {
+			return nil, fmt.Errorf("load conformance manifest: %w", err)
+		}
+		report.CorpusVersion = manifest.CorpusVersion
+
+		expectedOverrides = make(map[string]*IndicatorResult, len(manifest.Vectors))
+		for _, v := range manifest.Vectors {
+			var expected IndicatorResult
+			if err := json.Unmarshal(v.Expected, &expected); err != nil {
+				return nil, fmt.Errorf("vector %s: unmarshal expected block: %w", v.ID, err)
+			}
+			expectedOverrides[v.ID] = &expected
+			snapshots = append(snapshots, &Snapshot{
+				ID:       v.ID,
+				Symbol:   v.Symbol,
+				Interval: v.Interval,
+				Klines:   v.Klines,
+			})
+		}
+		if len(snapshots) == 0 {
+			return nil, fmt.Errorf("no vectors found in conformance corpus %s", e.config.DatasetDir)
+		}
+	} else if e.config.Mode == "static" {
 		logger.Infof("Loading snapshots from %s", e.config.DatasetDir)
 		snapshots, err = LoadSnapshots(e.config.DatasetDir)
 		if err != nil {
@@ -56,6 +120,8 @@ func (e *Engine) Run(ctx context.Context) (*BenchReport, error) {
 		if len(snapshots) == 0 {
 			return nil, fmt.Errorf("no snapshots found in %s", e.config.DatasetDir)
 		}
+	} else if e.config.Mode == "realtime" && e.config.StreamProvider != "" {
+		return e.runStreamingBenchmark(ctx, report)
 	} else {
 		logger.Infof("Capturing realtime snapshots for symbols: %v", e.config.Symbols)
 		for _, symbol := range e.config.Symbols {
@@ -80,14 +146,58 @@ func (e *Engine) Run(ctx context.Context) (*BenchReport, error) {
 	var results []*BenchResult
 	var mu sync.Mutex
 
+	// taskResults accumulates TaskResult entries for each Task named in
+	// Config.Tasks (including "indicator", if named), keyed by
+	// Task.Name(). Left nil when
+	// Config.Tasks is empty so ResultsByTask stays absent from the report.
+	var taskResults map[string][]*TaskResult
+	var taskMu sync.Mutex
+	if len(e.config.Tasks) > 0 {
+		taskResults = make(map[string][]*TaskResult, len(e.config.Tasks))
+	}
+
+	pricing, err := LoadPricingTable(pricingPath(e.config))
+	if err != nil {
+		logger.Warnf("pricing table unavailable (%v), cost accounting will report $0", err)
+		pricing = nil
+	}
+
 	totalRuns := len(snapshots) * len(e.config.Models) * e.config.Runs
 	logger.Infof("Starting benchmark: %d snapshots x %d models x %d runs = %d total runs",
 		len(snapshots), len(e.config.Models), e.config.Runs, totalRuns)
 
-	for _, snapshot := range snapshots {
-		// Calculate expected results (ground truth)
-		expected := CalculateIndicators(snapshot.Klines)
-		prompt := BuildIndicatorPrompt(snapshot.Klines)
+	// spentUSD/costCount track actual spend so far so BudgetUSD can be
+	// checked against a live average cost-per-run instead of a one-shot
+	// estimate computed before any real usage numbers exist.
+	var spentUSD float64
+	var costSum float64
+	var costCount int
+	completedRuns := 0
+	budgetExceeded := false
+
+snapshotLoop:
+	for snapshotIdx, snapshot := range snapshots {
+		snapshotProgress.Set(float64(snapshotIdx))
+
+		// Calculate expected results (ground truth), unless a conformance
+		// corpus already pins them for this snapshot.
+		expected, ok := expectedOverrides[snapshot.ID]
+		if !ok {
+			expected = CalculateIndicators(snapshot.Klines)
+		}
+		// ticks is looked up once per snapshot (not per model/run) since
+		// it only depends on the snapshot's symbol; a lookup failure
+		// degrades to percent-only scoring for this snapshot rather than
+		// failing the whole run, mirroring the pricing table fallback.
+		var ticks *market.TickInfo
+		if e.config.Scoring.Mode == ScoringTickAware {
+			t, err := market.GetExchangeInfo(snapshot.Symbol)
+			if err != nil {
+				logger.Warnf("exchange info unavailable for %s (%v), falling back to percent-only scoring", snapshot.Symbol, err)
+			} else {
+				ticks = t
+			}
+		}
 
 		logger.Infof("Benchmarking snapshot %s", snapshot.ID)
 
@@ -96,6 +206,29 @@ func (e *Engine) Run(ctx context.Context) (*BenchReport, error) {
 				logger.Infof("  Run %d/%d", runIdx+1, e.config.Runs)
 			}
 
+			if e.config.BudgetUSD > 0 && pricing != nil && costCount > 0 {
+				remaining := totalRuns - completedRuns
+				avgCost := costSum / float64(costCount)
+				projected := avgCost * float64(remaining)
+				if spentUSD+projected > e.config.BudgetUSD {
+					logger.Warnf("budget $%.2f would be exceeded (spent $%.2f, projected $%.2f over %d remaining runs); aborting sweep",
+						e.config.BudgetUSD, spentUSD, projected, remaining)
+					for s := snapshotIdx; s < len(snapshots); s++ {
+						for _, mc := range e.config.Models {
+							from := 0
+							if s == snapshotIdx {
+								from = runIdx
+							}
+							for r := from; r < e.config.Runs; r++ {
+								logger.Warnf("  skipped: snapshot=%s model=%s run=%d", snapshots[s].ID, mc.Name, r)
+							}
+						}
+					}
+					budgetExceeded = true
+					break snapshotLoop
+				}
+			}
+
 			// Run all models concurrently for this snapshot/run
 			var wg sync.WaitGroup
 			for _, modelCfg := range e.config.Models {
@@ -103,16 +236,33 @@ func (e *Engine) Run(ctx context.Context) (*BenchReport, error) {
 				go func(mc ModelConfig, run int) {
 					defer wg.Done()
 
-					result := e.runSingleBenchmark(ctx, snapshot.ID, &mc, prompt, expected, run)
+					result := e.runSingleBenchmark(ctx, snapshot.ID, &mc, snapshot.Klines, expected, run, pricing, ticks)
 
 					mu.Lock()
 					results = append(results, result)
+					spentUSD += result.CostUSD
+					costSum += result.CostUSD
+					costCount++
+					completedRuns++
 					mu.Unlock()
 
 					if result.Error != "" {
 						logger.Errorf("    %s: ERROR - %s", mc.Name, result.Error)
 					} else {
-						logger.Infof("    %s: Score=%.1f Latency=%v", mc.Name, result.TotalScore, result.Latency)
+						logger.Infof("    %s: Score=%.1f Latency=%v Cost=$%.4f", mc.Name, result.TotalScore, result.Latency, result.CostUSD)
+					}
+
+					for _, taskName := range e.config.Tasks {
+						task, ok := GetTask(taskName)
+						if !ok {
+							logger.Warnf("    unknown task %q, skipping", taskName)
+							continue
+						}
+						tr := e.runTaskBenchmark(ctx, task, snapshot, &mc, run)
+
+						taskMu.Lock()
+						taskResults[taskName] = append(taskResults[taskName], tr)
+						taskMu.Unlock()
 					}
 				}(modelCfg, runIdx)
 			}
@@ -125,7 +275,13 @@ func (e *Engine) Run(ctx context.Context) (*BenchReport, error) {
 		}
 	}
 
+	if budgetExceeded {
+		logger.Warnf("flushing partial report after %d/%d runs (spent $%.2f of $%.2f budget)",
+			completedRuns, totalRuns, spentUSD, e.config.BudgetUSD)
+	}
+
 	report.Results = results
+	report.ResultsByTask = taskResults
 
 	// Step 3: Calculate statistics for each model
 	report.Statistics = e.calculateStatistics(results)
@@ -133,11 +289,19 @@ func (e *Engine) Run(ctx context.Context) (*BenchReport, error) {
 	// Step 4: Calculate leaderboard
 	report.Leaderboard = e.calculateLeaderboard(report.Statistics)
 
+	if e.config.ReportsDir != "" {
+		if err := SaveReport(report, e.config.ReportsDir); err != nil {
+			logger.Warnf("failed to save report: %v", err)
+		}
+	}
+
 	return report, nil
 }
 
-// runSingleBenchmark runs a benchmark for a single model on a single snapshot
-func (e *Engine) runSingleBenchmark(ctx context.Context, snapshotID string, modelCfg *ModelConfig, prompt string, expected *IndicatorResult, runIndex int) *BenchResult {
+// runSingleBenchmark runs a benchmark for a single model on a single
+// snapshot. The prompt is built per-model (not once per snapshot) so it
+// can be tailored to client.Capabilities().
+func (e *Engine) runSingleBenchmark(ctx context.Context, snapshotID string, modelCfg *ModelConfig, klines []market.Kline, expected *IndicatorResult, runIndex int, pricing *PricingTable, ticks *market.TickInfo) *BenchResult {
 	result := &BenchResult{
 		SnapshotID: snapshotID,
 		Model:      modelCfg.Name,
@@ -152,30 +316,248 @@ func (e *Engine) runSingleBenchmark(ctx context.Context, snapshotID string, mode
 	}
 
 	client := NewLLMClient(modelCfg)
+	prompt := BuildIndicatorPrompt(klines, client.Capabilities())
 
-	start := time.Now()
-	response, err := client.Chat(ctx, prompt)
-	result.Latency = time.Since(start)
+	inFlightGoroutines.Inc()
+	defer inFlightGoroutines.Dec()
+
+	var response string
+	var err error
+
+	streamingClient, canStream := client.(StreamingClient)
+	cacheOff := e.config.CacheMode == "" || e.config.CacheMode == string(cache.ModeOff)
+	if e.config.Streaming && canStream && cacheOff {
+		response, err = e.runStreamingChat(ctx, streamingClient, prompt, result)
+		result.InputTokens = result.PromptTokens
+		result.OutputTokens = result.CompletionTokens
+	} else {
+		var cr chatResult
+		cr, err = e.cachedChat(ctx, client, modelCfg, snapshotID, prompt)
+		response = cr.Response
+		result.Latency = cr.Latency
+		result.InputTokens = cr.InputTokens
+		result.OutputTokens = cr.OutputTokens
+	}
 	result.RawOutput = response
+	result.CostUSD = pricing.CostUSD(modelCfg.Provider, modelCfg.Model, result.InputTokens, result.OutputTokens)
+
+	llmLatencySeconds.WithLabelValues(modelCfg.Provider, modelCfg.Model).Observe(result.Latency.Seconds())
 
 	if err != nil {
 		result.Error = err.Error()
+		llmRequestsTotal.WithLabelValues(modelCfg.Provider, modelCfg.Model, "error").Inc()
 		return result
 	}
 
 	actual, err := ParseIndicatorResponse(response)
 	if err != nil {
 		result.Error = fmt.Sprintf("parse response: %v", err)
+		llmRequestsTotal.WithLabelValues(modelCfg.Provider, modelCfg.Model, "parse_error").Inc()
 		return result
 	}
 
 	result.Actual = actual
-	result.Scores, result.Errors = ScoreIndicators(expected, actual)
+	result.Scores, result.Errors = ScoreIndicatorsWithPolicy(expected, actual, e.config.Scoring, ticks)
 	result.TotalScore = CalculateTotalScore(result.Scores)
 
+	llmRequestsTotal.WithLabelValues(modelCfg.Provider, modelCfg.Model, "ok").Inc()
+	observeIndicatorScores(modelCfg.Name, result.Scores)
+
 	return result
 }
 
+// chatResult is the outcome of a cachedChat call: the response text plus
+// whatever metadata the caller needs to record on its own result type,
+// regardless of whether it came from a cache hit or a live call.
+type chatResult struct {
+	Response     string
+	Latency      time.Duration
+	InputTokens  int
+	OutputTokens int
+}
+
+// cachedChat calls client.Chat through the Engine's llm/cache Store
+// according to CacheMode, returning a chatResult the caller records onto
+// its own result type. CacheMode off (or a store that fails to open)
+// falls back to calling client.Chat directly.
+func (e *Engine) cachedChat(ctx context.Context, client ProviderClient, modelCfg *ModelConfig, snapshotID, prompt string) (chatResult, error) {
+	store, err := e.cache()
+	if err != nil {
+		logger.Warnf("cache unavailable (%v), calling %s directly", err, modelCfg.Name)
+		store = nil
+	}
+
+	if store == nil {
+		start := time.Now()
+		response, err := client.Chat(ctx, prompt)
+		cr := chatResult{Response: response, Latency: time.Since(start)}
+		if ur, ok := client.(UsageReporter); ok {
+			u := ur.LastUsage()
+			cr.InputTokens = u.PromptTokens
+			cr.OutputTokens = u.CompletionTokens
+		}
+		return cr, err
+	}
+
+	key := cache.Key{
+		Provider:   modelCfg.Provider,
+		Model:      modelCfg.Model,
+		Prompt:     prompt,
+		SnapshotID: snapshotID,
+	}
+
+	entry, hit, err := store.Get(key)
+	if err != nil {
+		return chatResult{}, fmt.Errorf("cache lookup: %w", err)
+	}
+	if hit {
+		return chatResult{
+			Response:     entry.Response,
+			Latency:      entry.Latency,
+			InputTokens:  entry.PromptTokens,
+			OutputTokens: entry.CompletionTokens,
+		}, nil
+	}
+
+	if e.config.CacheMode == string(cache.ModeReplayOnly) {
+		return chatResult{}, fmt.Errorf("cache miss in replay-only mode for model %s, snapshot %s", modelCfg.Name, snapshotID)
+	}
+
+	start := time.Now()
+	response, err := client.Chat(ctx, prompt)
+	cr := chatResult{Response: response, Latency: time.Since(start)}
+	if ur, ok := client.(UsageReporter); ok {
+		u := ur.LastUsage()
+		cr.InputTokens = u.PromptTokens
+		cr.OutputTokens = u.CompletionTokens
+	}
+	if err != nil {
+		return cr, err
+	}
+
+	if putErr := store.Put(key, &cache.Entry{
+		Response:         response,
+		Latency:          cr.Latency,
+		PromptTokens:     cr.InputTokens,
+		CompletionTokens: cr.OutputTokens,
+	}); putErr != nil {
+		logger.Warnf("cache store failed for %s/%s: %v", modelCfg.Name, snapshotID, putErr)
+	}
+
+	return cr, nil
+}
+
+// runTaskBenchmark runs a single Task (pattern/regime/decision/...) for one
+// model on one snapshot. It mirrors runSingleBenchmark's Chat/score flow
+// but goes through the Task interface instead of the indicator-specific
+// prompt/parse/score functions, so it stays additive to the original
+// indicator benchmark rather than replacing it.
+func (e *Engine) runTaskBenchmark(ctx context.Context, task Task, snapshot *Snapshot, modelCfg *ModelConfig, runIndex int) *TaskResult {
+	result := &TaskResult{
+		SnapshotID: snapshot.ID,
+		Task:       task.Name(),
+		Model:      modelCfg.Name,
+		ModelInfo: &ModelInfo{
+			Provider:    modelCfg.Provider,
+			Model:       modelCfg.Model,
+			DisplayName: modelCfg.Name,
+			BaseURL:     modelCfg.BaseURL,
+		},
+		RunIndex: runIndex,
+		Expected: task.GroundTruth(snapshot.Klines),
+	}
+
+	client := NewLLMClient(modelCfg)
+	prompt := task.BuildPrompt(snapshot.Klines)
+
+	cr, err := e.cachedChat(ctx, client, modelCfg, snapshot.ID, prompt)
+	response := cr.Response
+	result.Latency = cr.Latency
+	result.RawOutput = response
+
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+
+	actual, err := task.ParseResponse(response)
+	if err != nil {
+		result.Error = fmt.Sprintf("parse response: %v", err)
+		return result
+	}
+
+	result.Actual = actual
+	scores, err := task.Score(result.Expected, actual)
+	if err != nil {
+		result.Error = fmt.Sprintf("score response: %v", err)
+		return result
+	}
+
+	result.Scores = scores
+	result.TotalScore = average(mapValues(scores))
+
+	return result
+}
+
+// mapValues returns the values of a map[string]float64 in unspecified
+// order, for feeding into average() when only the aggregate matters.
+func mapValues(m map[string]float64) []float64 {
+	values := make([]float64, 0, len(m))
+	for _, v := range m {
+		values = append(values, v)
+	}
+	return values
+}
+
+// runStreamingChat drains a StreamingClient's token channel, recording
+// TimeToFirstTokenMs/TokensPerSecond/PromptTokens/CompletionTokens onto
+// result, and returns the assembled response text.
+func (e *Engine) runStreamingChat(ctx context.Context, client StreamingClient, prompt string, result *BenchResult) (string, error) {
+	start := time.Now()
+	tokenCh, err := client.ChatStream(ctx, prompt)
+	if err != nil {
+		result.Latency = time.Since(start)
+		return "", err
+	}
+
+	var sb strings.Builder
+	var firstTokenAt time.Time
+	completionTokens := 0
+
+	for tok := range tokenCh {
+		if tok.Err != nil {
+			result.Latency = time.Since(start)
+			return sb.String(), tok.Err
+		}
+		if tok.Content != "" {
+			if firstTokenAt.IsZero() {
+				firstTokenAt = time.Now()
+				result.TimeToFirstTokenMs = float64(firstTokenAt.Sub(start).Milliseconds())
+			}
+			sb.WriteString(tok.Content)
+			completionTokens++
+		}
+		if tok.Done {
+			if tok.PromptTokens > 0 {
+				result.PromptTokens = tok.PromptTokens
+			}
+			if tok.CompletionTokens > 0 {
+				result.CompletionTokens = tok.CompletionTokens
+			}
+		}
+	}
+
+	result.Latency = time.Since(start)
+	if result.CompletionTokens == 0 {
+		result.CompletionTokens = completionTokens
+	}
+	if secs := result.Latency.Seconds(); secs > 0 && result.CompletionTokens > 0 {
+		result.TokensPerSecond = float64(result.CompletionTokens) / secs
+	}
+
+	return sb.String(), nil
+}
+
 // calculateStatistics computes statistics for each model
 func (e *Engine) calculateStatistics(results []*BenchResult) []*ModelStatistics {
 	// Group results by model
@@ -207,6 +589,8 @@ func (e *Engine) calculateStatistics(results []*BenchResult) []*ModelStatistics
 		indicatorCounts := make(map[string]int)
 
 		for _, r := range rs {
+			stat.TotalCostUSD += r.CostUSD
+
 			if r.Error != "" {
 				stat.FailureCount++
 				continue
@@ -241,6 +625,9 @@ func (e *Engine) calculateStatistics(results []*BenchResult) []*ModelStatistics
 			stat.AvgLatencyMs = average(latencies)
 			stat.MinLatencyMs = min(latencies)
 			stat.MaxLatencyMs = max(latencies)
+			stat.LatencyP50Ms = percentile(latencies, 50)
+			stat.LatencyP95Ms = percentile(latencies, 95)
+			stat.LatencyP99Ms = percentile(latencies, 99)
 
 			// Calculate consistency (higher is better)
 			if stat.AvgScore > 0 {
@@ -256,6 +643,10 @@ func (e *Engine) calculateStatistics(results []*BenchResult) []*ModelStatistics
 					stat.IndicatorAvgs[k] = sum / float64(count)
 				}
 			}
+
+			if stat.AvgScore > 0 {
+				stat.CostPerScorePoint = stat.TotalCostUSD / stat.AvgScore
+			}
 		}
 
 		stats = append(stats, stat)
@@ -275,14 +666,16 @@ func (e *Engine) calculateLeaderboard(stats []*ModelStatistics) []LeaderboardEnt
 
 	for _, s := range stats {
 		entries = append(entries, LeaderboardEntry{
-			Model:       s.Model,
-			Provider:    s.ModelInfo.Provider,
-			ModelID:     s.ModelInfo.Model,
-			AvgScore:    s.AvgScore,
-			StdDev:      s.StdDev,
-			Consistency: s.Consistency,
-			AvgLatency:  s.AvgLatencyMs,
-			RunCount:    s.RunCount,
+			Model:             s.Model,
+			Provider:          s.ModelInfo.Provider,
+			ModelID:           s.ModelInfo.Model,
+			AvgScore:          s.AvgScore,
+			StdDev:            s.StdDev,
+			Consistency:       s.Consistency,
+			AvgLatency:        s.AvgLatencyMs,
+			LatencyP95:        s.LatencyP95Ms,
+			RunCount:          s.RunCount,
+			CostPerScorePoint: s.CostPerScorePoint,
 		})
 	}
 
@@ -296,6 +689,21 @@ func (e *Engine) calculateLeaderboard(stats []*ModelStatistics) []LeaderboardEnt
 		entries[i].Rank = i + 1
 	}
 
+	// Cost-efficiency rank: lowest $/score-point first, among entries that
+	// actually have pricing data (CostPerScorePoint > 0).
+	priced := make([]int, 0, len(entries))
+	for i, e := range entries {
+		if e.CostPerScorePoint > 0 {
+			priced = append(priced, i)
+		}
+	}
+	sort.Slice(priced, func(i, j int) bool {
+		return entries[priced[i]].CostPerScorePoint < entries[priced[j]].CostPerScorePoint
+	})
+	for rank, idx := range priced {
+		entries[idx].CostEfficiencyRank = rank + 1
+	}
+
 	return entries
 }
 
@@ -336,6 +744,29 @@ func max(values []float64) float64 {
 	return m
 }
 
+// percentile returns the p-th percentile (0-100) of values using
+// linear interpolation between the two nearest ranks, since tail latency (P95/P99) matters more
+// than min/max/avg for the debate engine's real deadlines.
+func percentile(values []float64, p float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+
+	sorted := make([]float64, len(values))
+	copy(sorted, values)
+	sort.Float64s(sorted)
+
+	rank := (p / 100) * float64(len(sorted)-1)
+	lo := int(math.Floor(rank))
+	hi := int(math.Ceil(rank))
+	if lo == hi {
+		return sorted[lo]
+	}
+
+	frac := rank - float64(lo)
+	return sorted[lo] + (sorted[hi]-sorted[lo])*frac
+}
+
 func stdDev(values []float64) float64 {
 	if len(values) < 2 {
 		return 0
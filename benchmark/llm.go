@@ -1,119 +1,22 @@
 package benchmark
 
 import (
-	"bytes"
-	"context"
 	"encoding/json"
 	"fmt"
-	"io"
-	"net/http"
 	"regexp"
 	"strings"
-	"time"
 
 	"FinBench/market"
 )
 
-// ChatRequest represents an OpenAI-compatible chat request
-type ChatRequest struct {
-	Model    string        `json:"model"`
-	Messages []ChatMessage `json:"messages"`
-}
-
-// ChatMessage represents a chat message
-type ChatMessage struct {
-	Role    string `json:"role"`
-	Content string `json:"content"`
-}
-
-// ChatResponse represents an OpenAI-compatible chat response
-type ChatResponse struct {
-	ID      string `json:"id"`
-	Choices []struct {
-		Message struct {
-			Content string `json:"content"`
-		} `json:"message"`
-	} `json:"choices"`
-	Error *struct {
-		Message string `json:"message"`
-	} `json:"error,omitempty"`
-}
-
-// LLMClient is a client for calling LLM APIs
-type LLMClient struct {
-	config  *ModelConfig
-	baseURL string
-	client  *http.Client
-}
-
-// NewLLMClient creates a new LLM client
-func NewLLMClient(config *ModelConfig) *LLMClient {
-	baseURL := config.BaseURL
-	if baseURL == "" {
-		baseURL = GetBaseURL(config.Provider)
-	}
-
-	return &LLMClient{
-		config:  config,
-		baseURL: baseURL,
-		client: &http.Client{
-			Timeout: 120 * time.Second,
-		},
-	}
-}
-
-// Chat sends a chat request and returns the response
-func (c *LLMClient) Chat(ctx context.Context, prompt string) (string, error) {
-	reqBody := ChatRequest{
-		Model: c.config.Model,
-		Messages: []ChatMessage{
-			{Role: "user", Content: prompt},
-		},
-	}
-
-	jsonData, err := json.Marshal(reqBody)
-	if err != nil {
-		return "", fmt.Errorf("marshal request: %w", err)
-	}
-
-	url := c.baseURL + "/chat/completions"
-	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(jsonData))
-	if err != nil {
-		return "", fmt.Errorf("create request: %w", err)
-	}
-
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Authorization", "Bearer "+c.config.APIKey)
-
-	resp, err := c.client.Do(req)
-	if err != nil {
-		return "", fmt.Errorf("request failed: %w", err)
-	}
-	defer resp.Body.Close()
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return "", fmt.Errorf("read response: %w", err)
-	}
-
-	var result ChatResponse
-	if err := json.Unmarshal(body, &result); err != nil {
-		return "", fmt.Errorf("unmarshal response: %w, body: %s", err, string(body))
-	}
-
-	if result.Error != nil {
-		return "", fmt.Errorf("API error: %s", result.Error.Message)
-	}
-
-	if len(result.Choices) == 0 {
-		return "", fmt.Errorf("no response choices")
-	}
-
-	return result.Choices[0].Message.Content, nil
-}
-
-// BuildIndicatorPrompt builds a prompt for indicator calculation (English version)
-func BuildIndicatorPrompt(klines []market.Kline) string {
+// BuildIndicatorPrompt builds a prompt for indicator calculation (English
+// version). caps is consulted to decide how hard the prompt needs to lean
+// on plain-text instructions: providers without native JSONMode get an
+// extra nudge against markdown fences and commentary. Only the
+// OpenAI-compatible clients that report JSONMode also send a JSON
+// response-format hint; the Gemini client reports JSONMode without
+// sending one, so nothing at the API level enforces JSON there.
+func BuildIndicatorPrompt(klines []market.Kline, caps Capabilities) string {
 	var sb strings.Builder
 
 	sb.WriteString("Below is the K-line (candlestick) data sorted from oldest to newest:\n")
@@ -157,6 +60,10 @@ Requirements:
 - For RSI, use Wilder's smoothing method
 - Return ONLY the JSON object, no explanations`, len(klines)))
 
+	if !caps.JSONMode {
+		sb.WriteString("\n- Do not wrap the JSON in markdown code fences or add any text before or after it")
+	}
+
 	return sb.String()
 }
 
@@ -0,0 +1,108 @@
+package benchmark
+
+import (
+	"net/http"
+	"net/http/pprof"
+	"os"
+	cpuprofile "runtime/pprof"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"FinBench/logger"
+)
+
+var (
+	llmRequestsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "finbench_llm_requests_total",
+		Help: "Total number of LLM chat requests made by the benchmark runner.",
+	}, []string{"provider", "model", "status"})
+
+	llmLatencySeconds = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "finbench_llm_latency_seconds",
+		Help:    "Latency of LLM chat requests in seconds.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"provider", "model"})
+
+	indicatorScore = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "finbench_indicator_score",
+		Help:    "Per-indicator score (0-100) awarded to a model's response.",
+		Buckets: []float64{0, 20, 40, 60, 80, 100},
+	}, []string{"indicator", "model"})
+
+	inFlightGoroutines = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "finbench_inflight_requests",
+		Help: "Number of LLM requests currently in flight.",
+	})
+
+	snapshotProgress = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "finbench_snapshot_progress",
+		Help: "Index of the snapshot currently being benchmarked.",
+	})
+)
+
+// serveMetrics starts the optional pprof + Prometheus HTTP server at addr.
+// It runs in the background for the lifetime of the process; callers are
+// not expected to shut it down since a benchmark run is a one-shot process.
+func serveMetrics(addr string) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	mux.Handle("/metrics", promhttp.Handler())
+
+	logger.Infof("Metrics server listening on %s (/metrics, /debug/pprof/*)", addr)
+	go func() {
+		if err := http.ListenAndServe(addr, mux); err != nil {
+			logger.Errorf("metrics server stopped: %v", err)
+		}
+	}()
+}
+
+// observeIndicatorScores records each per-indicator score for model in the
+// finbench_indicator_score histogram.
+func observeIndicatorScores(model string, scores *IndicatorScores) {
+	if scores == nil {
+		return
+	}
+	indicatorScore.WithLabelValues("ma20", model).Observe(scores.MA20)
+	indicatorScore.WithLabelValues("ema12", model).Observe(scores.EMA12)
+	indicatorScore.WithLabelValues("ema26", model).Observe(scores.EMA26)
+	indicatorScore.WithLabelValues("macd", model).Observe(scores.MACD)
+	indicatorScore.WithLabelValues("rsi14", model).Observe(scores.RSI14)
+	indicatorScore.WithLabelValues("boll_upper", model).Observe(scores.BOLLUp)
+	indicatorScore.WithLabelValues("boll_middle", model).Observe(scores.BOLLMid)
+	indicatorScore.WithLabelValues("boll_lower", model).Observe(scores.BOLLLow)
+	indicatorScore.WithLabelValues("atr14", model).Observe(scores.ATR14)
+	indicatorScore.WithLabelValues("volume_ma5", model).Observe(scores.VolumeMA)
+}
+
+// startCPUProfile begins writing a pprof CPU profile to path and returns a
+// stop function the caller must invoke (typically via defer) when the
+// profiled work is done. It returns a no-op stop function if path is empty
+// or the profile file can't be created.
+func startCPUProfile(path string) (stop func()) {
+	if path == "" {
+		return func() {}
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		logger.Errorf("create cpu profile %s: %v", path, err)
+		return func() {}
+	}
+
+	if err := cpuprofile.StartCPUProfile(f); err != nil {
+		logger.Errorf("start cpu profile: %v", err)
+		f.Close()
+		return func() {}
+	}
+
+	return func() {
+		cpuprofile.StopCPUProfile()
+		f.Close()
+	}
+}
@@ -0,0 +1,145 @@
+package benchmark
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// defaultPricingPath is where Engine.Run looks for the pricing table when
+// BenchConfig.PricingPath is unset.
+const defaultPricingPath = "configs/pricing.yaml"
+
+// PricingEntry is the USD-per-1M-token rate for one provider+model pair.
+type PricingEntry struct {
+	Provider    string
+	Model       string
+	InputPer1M  float64
+	OutputPer1M float64
+}
+
+// CostUSD returns the dollar cost of a call using this entry's rates.
+func (e PricingEntry) CostUSD(inputTokens, outputTokens int) float64 {
+	return float64(inputTokens)/1_000_000*e.InputPer1M + float64(outputTokens)/1_000_000*e.OutputPer1M
+}
+
+// PricingTable looks up PricingEntry by provider+model.
+type PricingTable struct {
+	entries map[string]PricingEntry
+}
+
+func pricingKey(provider, model string) string {
+	return provider + "/" + model
+}
+
+// Lookup returns the PricingEntry for provider+model, if one was loaded.
+func (t *PricingTable) Lookup(provider, model string) (PricingEntry, bool) {
+	if t == nil {
+		return PricingEntry{}, false
+	}
+	e, ok := t.entries[pricingKey(provider, model)]
+	return e, ok
+}
+
+// CostUSD returns the dollar cost of inputTokens/outputTokens against
+// provider+model's rate, or 0 if no rate is configured for that pair.
+func (t *PricingTable) CostUSD(provider, model string, inputTokens, outputTokens int) float64 {
+	e, ok := t.Lookup(provider, model)
+	if !ok {
+		return 0
+	}
+	return e.CostUSD(inputTokens, outputTokens)
+}
+
+// LoadPricingTable reads a pricing list from a YAML file shaped like:
+//
+//   - provider: openai
+//     model: gpt-5.2
+//     input_per_1m: 5.00
+//     output_per_1m: 15.00
+//
+// This is a deliberately minimal parser for that one flat list-of-maps
+// shape (not a general YAML parser): it only understands top-level "- "
+// list items and indented "key: value" lines belonging to the current
+// item, which is all configs/pricing.yaml needs.
+func LoadPricingTable(path string) (*PricingTable, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("open pricing file: %w", err)
+	}
+	defer f.Close()
+
+	table := &PricingTable{entries: make(map[string]PricingEntry)}
+	var current *PricingEntry
+
+	flush := func() error {
+		if current == nil {
+			return nil
+		}
+		if current.Provider == "" || current.Model == "" {
+			return fmt.Errorf("pricing entry missing provider or model")
+		}
+		table.entries[pricingKey(current.Provider, current.Model)] = *current
+		current = nil
+		return nil
+	}
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if i := strings.Index(line, "#"); i >= 0 {
+			line = line[:i]
+		}
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" {
+			continue
+		}
+
+		if strings.HasPrefix(trimmed, "- ") {
+			if err := flush(); err != nil {
+				return nil, err
+			}
+			current = &PricingEntry{}
+			trimmed = strings.TrimPrefix(trimmed, "- ")
+		}
+
+		if current == nil {
+			continue
+		}
+
+		key, value, ok := strings.Cut(trimmed, ":")
+		if !ok {
+			continue
+		}
+		key = strings.TrimSpace(key)
+		value = strings.TrimSpace(value)
+
+		switch key {
+		case "provider":
+			current.Provider = value
+		case "model":
+			current.Model = value
+		case "input_per_1m":
+			current.InputPer1M, _ = strconv.ParseFloat(value, 64)
+		case "output_per_1m":
+			current.OutputPer1M, _ = strconv.ParseFloat(value, 64)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read pricing file: %w", err)
+	}
+	if err := flush(); err != nil {
+		return nil, err
+	}
+
+	return table, nil
+}
+
+func pricingPath(config *BenchConfig) string {
+	if config.PricingPath != "" {
+		return config.PricingPath
+	}
+	return defaultPricingPath
+}
@@ -0,0 +1,430 @@
+package benchmark
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Capabilities reports what a provider's API supports, so callers (e.g.
+// BuildIndicatorPrompt) can request stricter output where available instead
+// of relying on free-text JSON extraction for every provider.
+type Capabilities struct {
+	JSONMode         bool // provider accepts a response_format/json hint
+	StructuredOutput bool // provider supports schema-constrained output (tool use, function calling, etc.)
+}
+
+// ProviderClient is implemented by every native per-provider LLM client.
+// Each implementation owns its own auth, request envelope, response
+// parsing, and error shape, since the wire format differs enough between
+// providers (OpenAI-style /chat/completions vs. Claude's /v1/messages vs.
+// Gemini's generateContent) that a single shared client silently measures
+// the wrong thing for non-OpenAI-compatible providers.
+type ProviderClient interface {
+	Chat(ctx context.Context, prompt string) (string, error)
+	Capabilities() Capabilities
+}
+
+// Usage is the token accounting a provider reported for the most recent
+// Chat call, used to price that call against the Pricing table.
+type Usage struct {
+	PromptTokens     int
+	CompletionTokens int
+}
+
+// UsageReporter is implemented by ProviderClients that can report the
+// token usage of their last Chat call. Not every provider response
+// includes usage (or the caller may be using ChatStream instead), so
+// callers must type-assert rather than assume it's always available.
+type UsageReporter interface {
+	LastUsage() Usage
+}
+
+// NewLLMClient creates the ProviderClient appropriate for config.Provider.
+func NewLLMClient(config *ModelConfig) ProviderClient {
+	httpClient := &http.Client{Timeout: 120 * time.Second}
+
+	switch config.Provider {
+	case ProviderClaude:
+		return newClaudeClient(config, httpClient)
+	case ProviderGemini:
+		return newGeminiClient(config, httpClient)
+	case ProviderDeepSeek:
+		base := newOpenAICompatibleClient(config, httpClient)
+		base.jsonMode = true
+		return &deepseekClient{base}
+	case ProviderQwen:
+		base := newOpenAICompatibleClient(config, httpClient)
+		base.jsonMode = true
+		return &qwenClient{base}
+	case ProviderGrok:
+		return &grokClient{newOpenAICompatibleClient(config, httpClient)}
+	case ProviderKimi:
+		return &kimiClient{newOpenAICompatibleClient(config, httpClient)}
+	default:
+		base := newOpenAICompatibleClient(config, httpClient)
+		base.jsonMode = true
+		return &openaiClient{base}
+	}
+}
+
+// ===== OpenAI-compatible providers (DeepSeek, Qwen compatible-mode, OpenAI, Grok, Kimi) =====
+
+// chatRequest represents an OpenAI-compatible chat request.
+type chatRequest struct {
+	Model          string        `json:"model"`
+	Messages       []chatMessage `json:"messages"`
+	ResponseFormat *responseFmt  `json:"response_format,omitempty"`
+}
+
+type responseFmt struct {
+	Type string `json:"type"`
+}
+
+type chatMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+// chatResponse represents an OpenAI-compatible chat response.
+type chatResponse struct {
+	ID      string `json:"id"`
+	Choices []struct {
+		Message struct {
+			Content string `json:"content"`
+		} `json:"message"`
+	} `json:"choices"`
+	Usage *struct {
+		PromptTokens     int `json:"prompt_tokens"`
+		CompletionTokens int `json:"completion_tokens"`
+	} `json:"usage,omitempty"`
+	Error *struct {
+		Message string `json:"message"`
+	} `json:"error,omitempty"`
+}
+
+// openAICompatibleClient posts {model, messages} to <baseURL>/chat/completions
+// with an "Authorization: Bearer" header. It backs every provider whose API
+// speaks the OpenAI chat-completions wire format.
+type openAICompatibleClient struct {
+	config    *ModelConfig
+	baseURL   string
+	client    *http.Client
+	jsonMode  bool
+	lastUsage Usage
+}
+
+func newOpenAICompatibleClient(config *ModelConfig, httpClient *http.Client) *openAICompatibleClient {
+	baseURL := config.BaseURL
+	if baseURL == "" {
+		baseURL = GetBaseURL(config.Provider)
+	}
+	return &openAICompatibleClient{config: config, baseURL: baseURL, client: httpClient}
+}
+
+func (c *openAICompatibleClient) Chat(ctx context.Context, prompt string) (string, error) {
+	reqBody := chatRequest{
+		Model: c.config.Model,
+		Messages: []chatMessage{
+			{Role: "user", Content: prompt},
+		},
+	}
+	if c.jsonMode {
+		reqBody.ResponseFormat = &responseFmt{Type: "json_object"}
+	}
+
+	jsonData, err := json.Marshal(reqBody)
+	if err != nil {
+		return "", fmt.Errorf("marshal request: %w", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", c.baseURL+"/chat/completions", bytes.NewBuffer(jsonData))
+	if err != nil {
+		return "", fmt.Errorf("create request: %w", err)
+	}
+
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Authorization", "Bearer "+c.config.APIKey)
+
+	resp, err := c.client.Do(httpReq)
+	if err != nil {
+		return "", fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("read response: %w", err)
+	}
+
+	var result chatResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		return "", fmt.Errorf("unmarshal response: %w, body: %s", err, string(body))
+	}
+
+	if result.Error != nil {
+		return "", fmt.Errorf("API error: %s", result.Error.Message)
+	}
+
+	if len(result.Choices) == 0 {
+		return "", fmt.Errorf("no response choices")
+	}
+
+	if result.Usage != nil {
+		c.lastUsage = Usage{PromptTokens: result.Usage.PromptTokens, CompletionTokens: result.Usage.CompletionTokens}
+	}
+
+	return result.Choices[0].Message.Content, nil
+}
+
+// LastUsage returns the token usage reported by the most recent Chat call.
+func (c *openAICompatibleClient) LastUsage() Usage { return c.lastUsage }
+
+// openaiClient calls the OpenAI /chat/completions API.
+type openaiClient struct{ *openAICompatibleClient }
+
+func (c *openaiClient) Capabilities() Capabilities {
+	return Capabilities{JSONMode: true, StructuredOutput: true}
+}
+
+// deepseekClient calls DeepSeek's OpenAI-compatible /chat/completions API.
+type deepseekClient struct{ *openAICompatibleClient }
+
+func (c *deepseekClient) Capabilities() Capabilities {
+	return Capabilities{JSONMode: true}
+}
+
+// qwenClient calls Alibaba Dashscope's OpenAI compatible-mode endpoint.
+type qwenClient struct{ *openAICompatibleClient }
+
+func (c *qwenClient) Capabilities() Capabilities {
+	return Capabilities{JSONMode: true}
+}
+
+// grokClient calls xAI's OpenAI-compatible /chat/completions API.
+type grokClient struct{ *openAICompatibleClient }
+
+func (c *grokClient) Capabilities() Capabilities {
+	return Capabilities{}
+}
+
+// kimiClient calls Moonshot's OpenAI-compatible /chat/completions API.
+type kimiClient struct{ *openAICompatibleClient }
+
+func (c *kimiClient) Capabilities() Capabilities {
+	return Capabilities{}
+}
+
+// ===== Claude =====
+
+const claudeAPIVersion = "2023-06-01"
+const claudeMaxTokens = 4096
+
+type claudeRequest struct {
+	Model     string        `json:"model"`
+	MaxTokens int           `json:"max_tokens"`
+	Messages  []chatMessage `json:"messages"`
+}
+
+type claudeResponse struct {
+	Content []struct {
+		Type string `json:"type"`
+		Text string `json:"text"`
+	} `json:"content"`
+	Usage *struct {
+		InputTokens  int `json:"input_tokens"`
+		OutputTokens int `json:"output_tokens"`
+	} `json:"usage,omitempty"`
+	Error *struct {
+		Type    string `json:"type"`
+		Message string `json:"message"`
+	} `json:"error,omitempty"`
+}
+
+// claudeClient speaks Claude's native /v1/messages API: x-api-key +
+// anthropic-version headers rather than a bearer token, and a top-level
+// "content" array instead of OpenAI's "choices".
+type claudeClient struct {
+	config    *ModelConfig
+	baseURL   string
+	client    *http.Client
+	lastUsage Usage
+}
+
+func newClaudeClient(config *ModelConfig, httpClient *http.Client) *claudeClient {
+	baseURL := config.BaseURL
+	if baseURL == "" {
+		baseURL = DefaultClaudeBaseURL
+	}
+	return &claudeClient{config: config, baseURL: baseURL, client: httpClient}
+}
+
+func (c *claudeClient) Capabilities() Capabilities {
+	return Capabilities{StructuredOutput: true}
+}
+
+func (c *claudeClient) Chat(ctx context.Context, prompt string) (string, error) {
+	reqBody := claudeRequest{
+		Model:     c.config.Model,
+		MaxTokens: claudeMaxTokens,
+		Messages: []chatMessage{
+			{Role: "user", Content: prompt},
+		},
+	}
+
+	jsonData, err := json.Marshal(reqBody)
+	if err != nil {
+		return "", fmt.Errorf("marshal request: %w", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", c.baseURL+"/messages", bytes.NewBuffer(jsonData))
+	if err != nil {
+		return "", fmt.Errorf("create request: %w", err)
+	}
+
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("x-api-key", c.config.APIKey)
+	httpReq.Header.Set("anthropic-version", claudeAPIVersion)
+
+	resp, err := c.client.Do(httpReq)
+	if err != nil {
+		return "", fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("read response: %w", err)
+	}
+
+	var result claudeResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		return "", fmt.Errorf("unmarshal response: %w, body: %s", err, string(body))
+	}
+
+	if result.Error != nil {
+		return "", fmt.Errorf("API error: %s: %s", result.Error.Type, result.Error.Message)
+	}
+
+	if len(result.Content) == 0 {
+		return "", fmt.Errorf("no response content")
+	}
+
+	if result.Usage != nil {
+		c.lastUsage = Usage{PromptTokens: result.Usage.InputTokens, CompletionTokens: result.Usage.OutputTokens}
+	}
+
+	return result.Content[0].Text, nil
+}
+
+// LastUsage returns the token usage reported by the most recent Chat call.
+func (c *claudeClient) LastUsage() Usage { return c.lastUsage }
+
+// ===== Gemini =====
+
+const defaultGeminiNativeBaseURL = "https://generativelanguage.googleapis.com/v1beta"
+
+type geminiRequest struct {
+	Contents []geminiContent `json:"contents"`
+}
+
+type geminiContent struct {
+	Parts []geminiPart `json:"parts"`
+}
+
+type geminiPart struct {
+	Text string `json:"text"`
+}
+
+type geminiResponse struct {
+	Candidates []struct {
+		Content geminiContent `json:"content"`
+	} `json:"candidates"`
+	UsageMetadata *struct {
+		PromptTokenCount     int `json:"promptTokenCount"`
+		CandidatesTokenCount int `json:"candidatesTokenCount"`
+	} `json:"usageMetadata,omitempty"`
+	Error *struct {
+		Message string `json:"message"`
+	} `json:"error,omitempty"`
+}
+
+// geminiClient speaks Gemini's native generateContent API: an API key
+// query parameter instead of an Authorization header, and a
+// contents/parts request schema instead of OpenAI's messages list.
+type geminiClient struct {
+	config    *ModelConfig
+	baseURL   string
+	client    *http.Client
+	lastUsage Usage
+}
+
+func newGeminiClient(config *ModelConfig, httpClient *http.Client) *geminiClient {
+	baseURL := config.BaseURL
+	if baseURL == "" {
+		baseURL = defaultGeminiNativeBaseURL
+	}
+	return &geminiClient{config: config, baseURL: baseURL, client: httpClient}
+}
+
+func (c *geminiClient) Capabilities() Capabilities {
+	return Capabilities{JSONMode: true}
+}
+
+func (c *geminiClient) Chat(ctx context.Context, prompt string) (string, error) {
+	reqBody := geminiRequest{
+		Contents: []geminiContent{
+			{Parts: []geminiPart{{Text: prompt}}},
+		},
+	}
+
+	jsonData, err := json.Marshal(reqBody)
+	if err != nil {
+		return "", fmt.Errorf("marshal request: %w", err)
+	}
+
+	endpoint := fmt.Sprintf("%s/models/%s:generateContent?key=%s", c.baseURL, c.config.Model, url.QueryEscape(c.config.APIKey))
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return "", fmt.Errorf("create request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.client.Do(httpReq)
+	if err != nil {
+		return "", fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("read response: %w", err)
+	}
+
+	var result geminiResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		return "", fmt.Errorf("unmarshal response: %w, body: %s", err, string(body))
+	}
+
+	if result.Error != nil {
+		return "", fmt.Errorf("API error: %s", result.Error.Message)
+	}
+
+	if len(result.Candidates) == 0 || len(result.Candidates[0].Content.Parts) == 0 {
+		return "", fmt.Errorf("no response candidates")
+	}
+
+	if result.UsageMetadata != nil {
+		c.lastUsage = Usage{PromptTokens: result.UsageMetadata.PromptTokenCount, CompletionTokens: result.UsageMetadata.CandidatesTokenCount}
+	}
+
+	return result.Candidates[0].Content.Parts[0].Text, nil
+}
+
+// LastUsage returns the token usage reported by the most recent Chat call.
+func (c *geminiClient) LastUsage() Usage { return c.lastUsage }
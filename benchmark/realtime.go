@@ -0,0 +1,129 @@
+package benchmark
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"FinBench/logger"
+	"FinBench/market"
+	"FinBench/market/stream"
+)
+
+// newStreamSource builds the market/stream.StreamSource named by
+// BenchConfig.StreamProvider.
+func newStreamSource(provider string) (stream.StreamSource, error) {
+	switch provider {
+	case "binance":
+		return stream.NewBinanceSource(false), nil
+	case "binance-futures":
+		return stream.NewBinanceSource(true), nil
+	case "okx":
+		return stream.NewOKXSource(), nil
+	case "coinbase":
+		return stream.NewCoinbaseSource(), nil
+	default:
+		return nil, fmt.Errorf("unknown stream provider %q", provider)
+	}
+}
+
+// runStreamingBenchmark subscribes to BenchConfig.StreamProvider for
+// BenchConfig.Symbols/Interval and fans every closed bar out to all
+// configured models concurrently, appending a BenchResult per model per
+// bar to report, until BenchConfig.StreamDuration elapses or ctx is
+// cancelled.
+func (e *Engine) runStreamingBenchmark(ctx context.Context, report *BenchReport) (*BenchReport, error) {
+	source, err := newStreamSource(e.config.StreamProvider)
+	if err != nil {
+		return nil, fmt.Errorf("create stream source: %w", err)
+	}
+	defer source.Close()
+
+	streamCtx := ctx
+	var cancel context.CancelFunc
+	if e.config.StreamDuration > 0 {
+		streamCtx, cancel = context.WithTimeout(ctx, e.config.StreamDuration)
+		defer cancel()
+	}
+
+	bars, err := source.Subscribe(streamCtx, e.config.Symbols, e.config.Interval)
+	if err != nil {
+		return nil, fmt.Errorf("subscribe to %s: %w", source.Name(), err)
+	}
+
+	pricing, err := LoadPricingTable(pricingPath(e.config))
+	if err != nil {
+		logger.Warnf("pricing table unavailable (%v), cost accounting will report $0", err)
+		pricing = nil
+	}
+
+	buffer := stream.NewRollingBuffer(e.config.KlineCount)
+
+	var results []*BenchResult
+	var mu sync.Mutex
+	var snapshots []*Snapshot
+
+	logger.Infof("Streaming realtime benchmark from %s: symbols=%v interval=%s", source.Name(), e.config.Symbols, e.config.Interval)
+
+	for bar := range bars {
+		if !bar.Closed {
+			continue
+		}
+
+		klines, ready := buffer.Push(bar.Symbol, bar.Kline)
+		if !ready {
+			continue
+		}
+
+		snapshot := &Snapshot{
+			ID:        fmt.Sprintf("%s_%s_%s_%d", source.Name(), bar.Symbol, bar.Interval, bar.Kline.CloseTime),
+			Symbol:    bar.Symbol,
+			Interval:  e.config.Interval,
+			Timestamp: time.Now().UnixMilli(),
+			Klines:    append([]market.Kline(nil), klines...),
+		}
+		snapshots = append(snapshots, snapshot)
+
+		expected := CalculateIndicators(snapshot.Klines)
+
+		var ticks *market.TickInfo
+		if e.config.Scoring.Mode == ScoringTickAware {
+			t, err := market.GetExchangeInfo(snapshot.Symbol)
+			if err != nil {
+				logger.Warnf("exchange info unavailable for %s (%v), falling back to percent-only scoring", snapshot.Symbol, err)
+			} else {
+				ticks = t
+			}
+		}
+
+		logger.Infof("Closed bar for %s: fanning out to %d models", bar.Symbol, len(e.config.Models))
+
+		var wg sync.WaitGroup
+		for _, modelCfg := range e.config.Models {
+			wg.Add(1)
+			go func(mc ModelConfig) {
+				defer wg.Done()
+				result := e.runSingleBenchmark(ctx, snapshot.ID, &mc, snapshot.Klines, expected, 0, pricing, ticks)
+
+				mu.Lock()
+				results = append(results, result)
+				mu.Unlock()
+
+				if result.Error != "" {
+					logger.Errorf("    %s: ERROR - %s", mc.Name, result.Error)
+				} else {
+					logger.Infof("    %s: Score=%.1f Latency=%v", mc.Name, result.TotalScore, result.Latency)
+				}
+			}(modelCfg)
+		}
+		wg.Wait()
+	}
+
+	report.Snapshots = snapshots
+	report.Results = results
+	report.Statistics = e.calculateStatistics(results)
+	report.Leaderboard = e.calculateLeaderboard(report.Statistics)
+
+	return report, nil
+}
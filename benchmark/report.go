@@ -0,0 +1,49 @@
+package benchmark
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// defaultReportsDir names the conventional reports directory, mirroring
+// datasets/snapshots for Snapshots. SaveReport and LoadReport do not fall
+// back to it; they always use the dir they are given.
+const defaultReportsDir = "reports"
+
+// SaveReport saves a completed BenchReport to dir, named after its ID, so
+// it can later be reloaded by LoadReport (e.g. for RescoreReport).
+func SaveReport(report *BenchReport, dir string) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal report: %w", err)
+	}
+
+	path := filepath.Join(dir, report.ID+".json")
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write report: %w", err)
+	}
+
+	return nil
+}
+
+// LoadReport loads the BenchReport with the given ID from dir.
+func LoadReport(dir, id string) (*BenchReport, error) {
+	path := filepath.Join(dir, id+".json")
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read report: %w", err)
+	}
+
+	var report BenchReport
+	if err := json.Unmarshal(data, &report); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal report: %w", err)
+	}
+
+	return &report, nil
+}
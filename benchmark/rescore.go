@@ -0,0 +1,78 @@
+package benchmark
+
+import (
+	"fmt"
+
+	"FinBench/logger"
+	"FinBench/market"
+)
+
+// RescoreReport re-parses and re-scores every result already stored in
+// report using the current ParseIndicatorResponse/ScoreIndicators/
+// CalculateTotalScore logic, then recomputes Statistics and Leaderboard
+// from the updated results. It never calls a provider or the llm/cache
+// Store: RawOutput is already captured on each BenchResult, so changing
+// a scoring tier or adding a new indicator can be replayed for free by
+// re-deriving Actual/Scores/TotalScore from that stored text.
+//
+// Conformance-mode reports (whose ground truth comes from a corpus
+// manifest rather than CalculateIndicators) aren't supported, since the
+// manifest expected values aren't persisted on the report; RescoreReport
+// returns an error for those.
+func RescoreReport(report *BenchReport) (*BenchReport, error) {
+	if report.CorpusVersion != "" {
+		return nil, fmt.Errorf("rescoring a conformance report (corpus %s) isn't supported: ground truth isn't persisted on BenchReport", report.CorpusVersion)
+	}
+
+	expectedBySnapshot := make(map[string]*IndicatorResult, len(report.Snapshots))
+	ticksBySnapshot := make(map[string]*market.TickInfo, len(report.Snapshots))
+	for _, snapshot := range report.Snapshots {
+		expectedBySnapshot[snapshot.ID] = CalculateIndicators(snapshot.Klines)
+
+		if report.Config != nil && report.Config.Scoring.Mode == ScoringTickAware {
+			if t, err := market.GetExchangeInfo(snapshot.Symbol); err != nil {
+				logger.Warnf("exchange info unavailable for %s (%v), falling back to percent-only scoring", snapshot.Symbol, err)
+			} else {
+				ticksBySnapshot[snapshot.ID] = t
+			}
+		}
+	}
+
+	rescored := make([]*BenchResult, len(report.Results))
+	for i, r := range report.Results {
+		next := *r
+
+		expected, ok := expectedBySnapshot[r.SnapshotID]
+		if !ok {
+			rescored[i] = &next
+			continue
+		}
+		next.Expected = expected
+
+		if next.Error != "" || next.RawOutput == "" {
+			rescored[i] = &next
+			continue
+		}
+
+		actual, err := ParseIndicatorResponse(next.RawOutput)
+		if err != nil {
+			next.Error = fmt.Sprintf("rescore: parse response: %v", err)
+			rescored[i] = &next
+			continue
+		}
+
+		next.Actual = actual
+		next.Scores, next.Errors = ScoreIndicatorsWithPolicy(expected, actual, report.Config.Scoring, ticksBySnapshot[r.SnapshotID])
+		next.TotalScore = CalculateTotalScore(next.Scores)
+		rescored[i] = &next
+	}
+
+	next := *report
+	next.Results = rescored
+
+	e := NewEngine(report.Config)
+	next.Statistics = e.calculateStatistics(rescored)
+	next.Leaderboard = e.calculateLeaderboard(next.Statistics)
+
+	return &next, nil
+}
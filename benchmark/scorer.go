@@ -2,25 +2,39 @@ package benchmark
 
 import (
 	"math"
+
+	"FinBench/market"
 )
 
 // ScoreFromError calculates score based on error percentage using tiered scoring
 // Error thresholds: ≤0.1% = 100, 0.1-1% = 80, 1-5% = 60, 5-10% = 40, >10% = 0
 func ScoreFromError(errorPct float64) float64 {
-	errorPct = math.Abs(errorPct)
+	return ScoreFromErrorTiers(errorPct, nil)
+}
 
-	switch {
-	case errorPct <= 0.1:
-		return 100
-	case errorPct <= 1:
-		return 80
-	case errorPct <= 5:
-		return 60
-	case errorPct <= 10:
-		return 40
-	default:
-		return 0
+// defaultScoreTiers is the built-in percent-error ladder used when a
+// ScoringPolicy doesn't supply its own Tiers.
+var defaultScoreTiers = []ScoreTier{
+	{MaxErrorPct: 0.1, Score: 100},
+	{MaxErrorPct: 1, Score: 80},
+	{MaxErrorPct: 5, Score: 60},
+	{MaxErrorPct: 10, Score: 40},
+}
+
+// ScoreFromErrorTiers scores errorPct against tiers (ascending
+// MaxErrorPct, first match wins), falling through to 0 if none match.
+// A nil/empty tiers uses defaultScoreTiers.
+func ScoreFromErrorTiers(errorPct float64, tiers []ScoreTier) float64 {
+	errorPct = math.Abs(errorPct)
+	if len(tiers) == 0 {
+		tiers = defaultScoreTiers
+	}
+	for _, t := range tiers {
+		if errorPct <= t.MaxErrorPct {
+			return t.Score
+		}
 	}
+	return 0
 }
 
 // CalculateError calculates the percentage error between expected and actual values
@@ -34,34 +48,126 @@ func CalculateError(expected, actual float64) float64 {
 	return math.Abs(expected-actual) / math.Abs(expected) * 100
 }
 
+// ScoreTier is one rung of a custom scoring ladder: errors at or below
+// MaxErrorPct (percent) score Score.
+type ScoreTier struct {
+	MaxErrorPct float64
+	Score       float64
+}
+
+// ScoringMode selects how ScoreIndicatorsWithPolicy turns a percent error
+// into a score.
+type ScoringMode string
+
+const (
+	// ScoringPercentOnly is the original behavior: score purely off
+	// percent error via defaultScoreTiers (or Tiers, if set).
+	ScoringPercentOnly ScoringMode = "percent_only"
+	// ScoringTickAware additionally credits answers that land within a
+	// symbol's exchange tick size, regardless of percent error — the
+	// meaningful precision for a price is the exchange's own quote
+	// granularity, not a fixed percentage, so a low-priced/high-precision
+	// symbol (e.g. SHIBUSDT at 1e-8) shouldn't be penalized for percent
+	// error that's actually sub-tick noise.
+	ScoringTickAware ScoringMode = "tick_aware"
+)
+
+// ScoringPolicy configures how BenchConfig wants indicator answers
+// scored. The zero value is ScoringPercentOnly with the built-in tiers,
+// so existing configs keep today's behavior unchanged.
+type ScoringPolicy struct {
+	Mode ScoringMode `json:"mode,omitempty"`
+	// Tiers overrides defaultScoreTiers for the percent-error fallback
+	// (and, under ScoringTickAware, for errors beyond the tick-aware
+	// bonus). Empty uses defaultScoreTiers.
+	Tiers []ScoreTier `json:"tiers,omitempty"`
+}
+
+// priceUnitIndicators are scored against PriceTickSize under
+// ScoringTickAware; RSI14 (bounded 0-100) and MACD (a derived
+// difference, not a quoted price) aren't symbol price units and are
+// always scored percent-only.
+var priceUnitIndicators = map[string]bool{
+	"ma20": true, "ema12": true, "ema26": true,
+	"boll_upper": true, "boll_middle": true, "boll_lower": true,
+}
+
+// tickAwareErrorPct folds a symbol's tick size into an indicator's
+// percent error: if the absolute error is within one tick of the
+// exchange's quote granularity, the answer is treated as an exact match
+// (errorPct 0) no matter how large the raw percent error looks; beyond
+// that it falls back to the plain percent error, so errors are still
+// scored against the same percent-unit tier ladder once they exceed a
+// single tick (a ticks-off count is dimensionless and can't be compared
+// against percent thresholds directly).
+func tickAwareErrorPct(percentErr, expected, actual, tickSize float64) float64 {
+	if tickSize <= 0 {
+		return percentErr
+	}
+
+	absErr := math.Abs(expected - actual)
+	// The small tolerance keeps an answer exactly one tick off from losing
+	// the credit to floating-point noise in absErr.
+	ticksOff := absErr / tickSize
+	if ticksOff <= 1+1e-9 {
+		return 0
+	}
+	return percentErr
+}
+
 // ScoreIndicators compares expected and actual results, returns scores and errors
 func ScoreIndicators(expected, actual *IndicatorResult) (*IndicatorScores, map[string]float64) {
+	return ScoreIndicatorsWithPolicy(expected, actual, ScoringPolicy{}, nil)
+}
+
+// ScoreIndicatorsWithPolicy is ScoreIndicators with an explicit
+// ScoringPolicy and, for ScoringTickAware, the symbol's market.TickInfo
+// (nil ticks falls back to percent-only scoring even under
+// ScoringTickAware, e.g. when GetExchangeInfo failed).
+func ScoreIndicatorsWithPolicy(expected, actual *IndicatorResult, policy ScoringPolicy, ticks *market.TickInfo) (*IndicatorScores, map[string]float64) {
 	errors := make(map[string]float64)
 	scores := &IndicatorScores{}
 
-	// Calculate errors
-	errors["ma20"] = CalculateError(expected.MA20, actual.MA20)
-	errors["ema12"] = CalculateError(expected.EMA12, actual.EMA12)
-	errors["ema26"] = CalculateError(expected.EMA26, actual.EMA26)
-	errors["macd"] = CalculateError(expected.MACD, actual.MACD)
-	errors["rsi14"] = CalculateError(expected.RSI14, actual.RSI14)
-	errors["boll_upper"] = CalculateError(expected.BOLLUp, actual.BOLLUp)
-	errors["boll_middle"] = CalculateError(expected.BOLLMid, actual.BOLLMid)
-	errors["boll_lower"] = CalculateError(expected.BOLLLow, actual.BOLLLow)
-	errors["atr14"] = CalculateError(expected.ATR14, actual.ATR14)
-	errors["volume_ma5"] = CalculateError(expected.VolumeMA, actual.VolumeMA)
-
-	// Calculate scores
-	scores.MA20 = ScoreFromError(errors["ma20"])
-	scores.EMA12 = ScoreFromError(errors["ema12"])
-	scores.EMA26 = ScoreFromError(errors["ema26"])
-	scores.MACD = ScoreFromError(errors["macd"])
-	scores.RSI14 = ScoreFromError(errors["rsi14"])
-	scores.BOLLUp = ScoreFromError(errors["boll_upper"])
-	scores.BOLLMid = ScoreFromError(errors["boll_middle"])
-	scores.BOLLLow = ScoreFromError(errors["boll_lower"])
-	scores.ATR14 = ScoreFromError(errors["atr14"])
-	scores.VolumeMA = ScoreFromError(errors["volume_ma5"])
+	raw := map[string][2]float64{
+		"ma20":        {expected.MA20, actual.MA20},
+		"ema12":       {expected.EMA12, actual.EMA12},
+		"ema26":       {expected.EMA26, actual.EMA26},
+		"macd":        {expected.MACD, actual.MACD},
+		"rsi14":       {expected.RSI14, actual.RSI14},
+		"boll_upper":  {expected.BOLLUp, actual.BOLLUp},
+		"boll_middle": {expected.BOLLMid, actual.BOLLMid},
+		"boll_lower":  {expected.BOLLLow, actual.BOLLLow},
+		"atr14":       {expected.ATR14, actual.ATR14},
+		"volume_ma5":  {expected.VolumeMA, actual.VolumeMA},
+	}
+
+	scored := make(map[string]float64, len(raw))
+	for name, pair := range raw {
+		exp, act := pair[0], pair[1]
+		errorPct := CalculateError(exp, act)
+
+		if policy.Mode == ScoringTickAware && ticks != nil {
+			if priceUnitIndicators[name] {
+				errorPct = tickAwareErrorPct(errorPct, exp, act, ticks.PriceTickSize)
+			} else if name == "volume_ma5" {
+				errorPct = tickAwareErrorPct(errorPct, exp, act, ticks.AmountTickSize)
+			}
+		}
+
+		errors[name] = errorPct
+		scored[name] = ScoreFromErrorTiers(errorPct, policy.Tiers)
+	}
+
+	scores.MA20 = scored["ma20"]
+	scores.EMA12 = scored["ema12"]
+	scores.EMA26 = scored["ema26"]
+	scores.MACD = scored["macd"]
+	scores.RSI14 = scored["rsi14"]
+	scores.BOLLUp = scored["boll_upper"]
+	scores.BOLLMid = scored["boll_middle"]
+	scores.BOLLLow = scored["boll_lower"]
+	scores.ATR14 = scored["atr14"]
+	scores.VolumeMA = scored["volume_ma5"]
 
 	return scores, errors
 }
@@ -0,0 +1,307 @@
+package benchmark
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Token is a single increment of a streamed chat response. Content is the
+// delta text for this token; the final Token on the channel has Done set
+// and, when the provider reports it, the prompt/completion token usage for
+// the whole response.
+type Token struct {
+	Content          string
+	Done             bool
+	Err              error
+	PromptTokens     int
+	CompletionTokens int
+}
+
+// StreamingClient is implemented by providers whose API supports token
+// streaming. Engine.runSingleBenchmark uses it (when BenchConfig.Streaming
+// is set) to measure time-to-first-token and tokens/sec, distinguishing a
+// model that is slow-but-steady from one that stalls and then dumps a
+// response.
+type StreamingClient interface {
+	ChatStream(ctx context.Context, prompt string) (<-chan Token, error)
+}
+
+// ===== OpenAI-compatible SSE streaming =====
+
+type streamChatRequest struct {
+	Model          string        `json:"model"`
+	Messages       []chatMessage `json:"messages"`
+	Stream         bool          `json:"stream"`
+	ResponseFormat *responseFmt  `json:"response_format,omitempty"`
+}
+
+type streamChunk struct {
+	Choices []struct {
+		Delta struct {
+			Content string `json:"content"`
+		} `json:"delta"`
+	} `json:"choices"`
+	Usage *struct {
+		PromptTokens     int `json:"prompt_tokens"`
+		CompletionTokens int `json:"completion_tokens"`
+	} `json:"usage,omitempty"`
+}
+
+// ChatStream streams a chat completion over SSE (text/event-stream), the
+// format shared by every OpenAI-compatible provider.
+func (c *openAICompatibleClient) ChatStream(ctx context.Context, prompt string) (<-chan Token, error) {
+	reqBody := streamChatRequest{
+		Model:  c.config.Model,
+		Stream: true,
+		Messages: []chatMessage{
+			{Role: "user", Content: prompt},
+		},
+	}
+	if c.jsonMode {
+		reqBody.ResponseFormat = &responseFmt{Type: "json_object"}
+	}
+
+	jsonData, err := json.Marshal(reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("marshal request: %w", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", c.baseURL+"/chat/completions", bytes.NewBuffer(jsonData))
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Authorization", "Bearer "+c.config.APIKey)
+	httpReq.Header.Set("Accept", "text/event-stream")
+
+	resp, err := c.client.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+
+	tokens := make(chan Token)
+	go func() {
+		defer close(tokens)
+		defer resp.Body.Close()
+
+		var usagePrompt, usageCompletion int
+		scanner := bufio.NewScanner(resp.Body)
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if !strings.HasPrefix(line, "data:") {
+				continue
+			}
+			data := strings.TrimSpace(strings.TrimPrefix(line, "data:"))
+			if data == "[DONE]" {
+				break
+			}
+
+			var chunk streamChunk
+			if err := json.Unmarshal([]byte(data), &chunk); err != nil {
+				continue
+			}
+			if chunk.Usage != nil {
+				usagePrompt = chunk.Usage.PromptTokens
+				usageCompletion = chunk.Usage.CompletionTokens
+			}
+			if len(chunk.Choices) > 0 && chunk.Choices[0].Delta.Content != "" {
+				tokens <- Token{Content: chunk.Choices[0].Delta.Content}
+			}
+		}
+
+		if err := scanner.Err(); err != nil {
+			tokens <- Token{Done: true, Err: fmt.Errorf("read stream: %w", err)}
+			return
+		}
+
+		tokens <- Token{Done: true, PromptTokens: usagePrompt, CompletionTokens: usageCompletion}
+	}()
+
+	return tokens, nil
+}
+
+// ===== Claude SSE streaming =====
+
+type claudeStreamRequest struct {
+	Model     string        `json:"model"`
+	MaxTokens int           `json:"max_tokens"`
+	Messages  []chatMessage `json:"messages"`
+	Stream    bool          `json:"stream"`
+}
+
+type claudeStreamEvent struct {
+	Type  string `json:"type"`
+	Delta *struct {
+		Text string `json:"text"`
+	} `json:"delta,omitempty"`
+	Usage *struct {
+		InputTokens  int `json:"input_tokens"`
+		OutputTokens int `json:"output_tokens"`
+	} `json:"usage,omitempty"`
+	Message *struct {
+		Usage struct {
+			InputTokens  int `json:"input_tokens"`
+			OutputTokens int `json:"output_tokens"`
+		} `json:"usage"`
+	} `json:"message,omitempty"`
+}
+
+// ChatStream streams a Claude response via its content_block_delta SSE
+// events on /v1/messages.
+func (c *claudeClient) ChatStream(ctx context.Context, prompt string) (<-chan Token, error) {
+	reqBody := claudeStreamRequest{
+		Model:     c.config.Model,
+		MaxTokens: claudeMaxTokens,
+		Stream:    true,
+		Messages: []chatMessage{
+			{Role: "user", Content: prompt},
+		},
+	}
+
+	jsonData, err := json.Marshal(reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("marshal request: %w", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", c.baseURL+"/messages", bytes.NewBuffer(jsonData))
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("x-api-key", c.config.APIKey)
+	httpReq.Header.Set("anthropic-version", claudeAPIVersion)
+	httpReq.Header.Set("Accept", "text/event-stream")
+
+	resp, err := c.client.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+
+	tokens := make(chan Token)
+	go func() {
+		defer close(tokens)
+		defer resp.Body.Close()
+
+		var promptTokens, completionTokens int
+		scanner := bufio.NewScanner(resp.Body)
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if !strings.HasPrefix(line, "data:") {
+				continue
+			}
+			data := strings.TrimSpace(strings.TrimPrefix(line, "data:"))
+
+			var event claudeStreamEvent
+			if err := json.Unmarshal([]byte(data), &event); err != nil {
+				continue
+			}
+
+			switch event.Type {
+			case "content_block_delta":
+				if event.Delta != nil && event.Delta.Text != "" {
+					tokens <- Token{Content: event.Delta.Text}
+				}
+			case "message_start":
+				if event.Message != nil {
+					promptTokens = event.Message.Usage.InputTokens
+				}
+			case "message_delta":
+				if event.Usage != nil {
+					completionTokens = event.Usage.OutputTokens
+				}
+			}
+		}
+
+		if err := scanner.Err(); err != nil {
+			tokens <- Token{Done: true, Err: fmt.Errorf("read stream: %w", err)}
+			return
+		}
+
+		tokens <- Token{Done: true, PromptTokens: promptTokens, CompletionTokens: completionTokens}
+	}()
+
+	return tokens, nil
+}
+
+// ===== Gemini SSE streaming =====
+
+type geminiStreamResponse struct {
+	Candidates []struct {
+		Content geminiContent `json:"content"`
+	} `json:"candidates"`
+	UsageMetadata *struct {
+		PromptTokenCount     int `json:"promptTokenCount"`
+		CandidatesTokenCount int `json:"candidatesTokenCount"`
+	} `json:"usageMetadata,omitempty"`
+}
+
+// ChatStream streams a Gemini response via streamGenerateContent?alt=sse.
+func (c *geminiClient) ChatStream(ctx context.Context, prompt string) (<-chan Token, error) {
+	reqBody := geminiRequest{
+		Contents: []geminiContent{
+			{Parts: []geminiPart{{Text: prompt}}},
+		},
+	}
+
+	jsonData, err := json.Marshal(reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("marshal request: %w", err)
+	}
+
+	endpoint := fmt.Sprintf("%s/models/%s:streamGenerateContent?alt=sse&key=%s", c.baseURL, c.config.Model, url.QueryEscape(c.config.APIKey))
+	httpReq, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return nil, fmt.Errorf("create request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.client.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+
+	tokens := make(chan Token)
+	go func() {
+		defer close(tokens)
+		defer resp.Body.Close()
+
+		var promptTokens, completionTokens int
+		scanner := bufio.NewScanner(resp.Body)
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if !strings.HasPrefix(line, "data:") {
+				continue
+			}
+			data := strings.TrimSpace(strings.TrimPrefix(line, "data:"))
+
+			var chunk geminiStreamResponse
+			if err := json.Unmarshal([]byte(data), &chunk); err != nil {
+				continue
+			}
+			if chunk.UsageMetadata != nil {
+				promptTokens = chunk.UsageMetadata.PromptTokenCount
+				completionTokens = chunk.UsageMetadata.CandidatesTokenCount
+			}
+			if len(chunk.Candidates) > 0 && len(chunk.Candidates[0].Content.Parts) > 0 {
+				if text := chunk.Candidates[0].Content.Parts[0].Text; text != "" {
+					tokens <- Token{Content: text}
+				}
+			}
+		}
+
+		if err := scanner.Err(); err != nil {
+			tokens <- Token{Done: true, Err: fmt.Errorf("read stream: %w", err)}
+			return
+		}
+
+		tokens <- Token{Done: true, PromptTokens: promptTokens, CompletionTokens: completionTokens}
+	}()
+
+	return tokens, nil
+}
@@ -0,0 +1,57 @@
+package benchmark
+
+import (
+	"time"
+
+	"FinBench/market"
+)
+
+// Task is a gradable unit of benchmark work: build a prompt from a window
+// of klines, parse a model's response, and score it against ground truth.
+// IndicatorTask (numerical indicator computation) is the original FinBench
+// behavior; PatternTask, RegimeTask, and DecisionTask turn FinBench into a
+// broader trading-reasoning benchmark matching how the nofx debate engine
+// actually uses these models. HistoricalIndicatorTask grades indicator
+// recall at specific historical bar offsets rather than only the latest
+// reading.
+type Task interface {
+	// Name identifies the task in BenchConfig.Tasks and BenchReport.ResultsByTask.
+	Name() string
+	BuildPrompt(klines []market.Kline) string
+	ParseResponse(response string) (any, error)
+	Score(expected, actual any) (map[string]float64, error)
+	GroundTruth(klines []market.Kline) any
+}
+
+// tasks holds the built-in Task registry, keyed by Name().
+var tasks = map[string]Task{
+	"indicator":  IndicatorTask{},
+	"pattern":    PatternTask{},
+	"regime":     RegimeTask{},
+	"decision":   DecisionTask{},
+	"historical": HistoricalIndicatorTask{},
+}
+
+// GetTask looks up a registered Task by name.
+func GetTask(name string) (Task, bool) {
+	t, ok := tasks[name]
+	return t, ok
+}
+
+// TaskResult holds the outcome of running one Task against one model on one
+// snapshot. Unlike BenchResult (which is indicator-specific), Expected and
+// Actual are the task's own ground-truth/parsed-response shapes.
+type TaskResult struct {
+	SnapshotID string             `json:"snapshot_id"`
+	Task       string             `json:"task"`
+	Model      string             `json:"model"`
+	ModelInfo  *ModelInfo         `json:"model_info"`
+	RunIndex   int                `json:"run_index"`
+	Expected   any                `json:"expected"`
+	Actual     any                `json:"actual"`
+	Scores     map[string]float64 `json:"scores"`
+	TotalScore float64            `json:"total_score"`
+	Latency    time.Duration      `json:"latency"`
+	RawOutput  string             `json:"raw_output"`
+	Error      string             `json:"error,omitempty"`
+}
@@ -0,0 +1,167 @@
+package benchmark
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"FinBench/market"
+)
+
+const (
+	// decisionForwardWindow is how many candles beyond the visible prompt
+	// history DecisionTask looks ahead to compute a forward-return ground
+	// truth. GroundTruth expects klines to include this many extra trailing
+	// bars past what BuildPrompt shows the model.
+	decisionForwardWindow = 5
+	// decisionMoveThresholdPct is the minimum forward move (as a percent of
+	// price) required to call for "long"/"short" rather than "hold".
+	decisionMoveThresholdPct = 0.5
+)
+
+// DecisionResult is a trade decision: an action plus the confidence and
+// risk levels a model would attach to it.
+type DecisionResult struct {
+	Action     string  `json:"action"`
+	Confidence float64 `json:"confidence"`
+	StopLoss   float64 `json:"stop_loss"`
+	TakeProfit float64 `json:"take_profit"`
+}
+
+// DecisionTask asks a model to produce a trade decision from visible
+// history, and scores it against the realized forward return of the next
+// decisionForwardWindow candles. GroundTruth requires klines to carry that
+// extra forward window; BuildPrompt only shows the model the visible
+// portion so it cannot see the outcome it's being scored against.
+type DecisionTask struct{}
+
+func (DecisionTask) Name() string { return "decision" }
+
+func (DecisionTask) visibleKlines(klines []market.Kline) []market.Kline {
+	if len(klines) <= decisionForwardWindow {
+		return klines
+	}
+	return klines[:len(klines)-decisionForwardWindow]
+}
+
+func (t DecisionTask) BuildPrompt(klines []market.Kline) string {
+	visible := t.visibleKlines(klines)
+
+	var sb strings.Builder
+	sb.WriteString("Below is the K-line (candlestick) data sorted from oldest to newest:\n")
+	sb.WriteString("Index | Open | High | Low | Close | Volume\n")
+	sb.WriteString("------|------|------|-----|-------|--------\n")
+
+	for i, k := range visible {
+		sb.WriteString(fmt.Sprintf("%d | %.2f | %.2f | %.2f | %.2f | %.2f\n",
+			i+1, k.Open, k.High, k.Low, k.Close, k.Volume))
+	}
+
+	sb.WriteString(`
+Based on the candlesticks above, produce a trade decision.
+
+Return ONLY a JSON object in the following format, with no additional text:
+{
+  "action": "long" | "short" | "hold",
+  "confidence": number (0-100),
+  "stop_loss": number,
+  "take_profit": number
+}
+
+Requirements:
+- stop_loss and take_profit are absolute prices, only meaningful when action is "long" or "short"
+- Return ONLY the JSON object, no explanations`)
+
+	return sb.String()
+}
+
+func (DecisionTask) ParseResponse(response string) (any, error) {
+	var result DecisionResult
+
+	if err := json.Unmarshal([]byte(response), &result); err == nil {
+		return &result, nil
+	}
+
+	re := regexp.MustCompile(`\{[^{}]*"action"[^{}]*\}`)
+	match := re.FindString(response)
+	if match == "" {
+		start := strings.Index(response, "{")
+		end := strings.LastIndex(response, "}")
+		if start != -1 && end != -1 && end > start {
+			match = response[start : end+1]
+		}
+	}
+	if match == "" {
+		return nil, fmt.Errorf("decision task: no JSON found in response")
+	}
+
+	if err := json.Unmarshal([]byte(match), &result); err != nil {
+		return nil, fmt.Errorf("decision task: parse JSON failed: %w", err)
+	}
+
+	return &result, nil
+}
+
+// GroundTruth computes the ideal action from the realized forward return
+// over decisionForwardWindow candles past the visible history, with
+// stop_loss/take_profit set one ATR away from the decision price.
+func (t DecisionTask) GroundTruth(klines []market.Kline) any {
+	visible := t.visibleKlines(klines)
+	if len(visible) == 0 || len(klines) <= len(visible) {
+		return &DecisionResult{Action: "hold"}
+	}
+
+	entry := visible[len(visible)-1].Close
+	future := klines[len(klines)-1].Close
+
+	movePct := 0.0
+	if entry != 0 {
+		movePct = (future - entry) / entry * 100
+	}
+
+	atr := market.CalculateATR(visible, 14)
+
+	result := &DecisionResult{Confidence: 100}
+	switch {
+	case movePct >= decisionMoveThresholdPct:
+		result.Action = "long"
+		result.StopLoss = entry - atr
+		result.TakeProfit = entry + atr
+	case movePct <= -decisionMoveThresholdPct:
+		result.Action = "short"
+		result.StopLoss = entry + atr
+		result.TakeProfit = entry - atr
+	default:
+		result.Action = "hold"
+	}
+
+	return result
+}
+
+func (DecisionTask) Score(expected, actual any) (map[string]float64, error) {
+	exp, ok := expected.(*DecisionResult)
+	if !ok {
+		return nil, fmt.Errorf("decision task: expected *DecisionResult, got %T", expected)
+	}
+	act, ok := actual.(*DecisionResult)
+	if !ok {
+		return nil, fmt.Errorf("decision task: actual *DecisionResult, got %T", actual)
+	}
+
+	actionScore := 0.0
+	if strings.EqualFold(exp.Action, act.Action) {
+		actionScore = 100.0
+	}
+
+	scores := map[string]float64{"action_match": actionScore}
+
+	if exp.Action != "hold" {
+		slErr := CalculateError(exp.StopLoss, act.StopLoss)
+		tpErr := CalculateError(exp.TakeProfit, act.TakeProfit)
+		scores["stop_loss"] = ScoreFromError(slErr)
+		scores["take_profit"] = ScoreFromError(tpErr)
+	}
+
+	return scores, nil
+}
@@ -0,0 +1,122 @@
+package benchmark
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"FinBench/market"
+)
+
+// historicalOffsets are the bar offsets (0 = latest closed bar) that
+// HistoricalIndicatorTask asks a model to read back, matching the style
+// of strategy prompts that back-reference prior bar values for exits and
+// trend checks.
+var historicalOffsets = []int{0, 1, 2, 3, 5}
+
+// HistoricalIndicatorTask grades a model's ability to read indicator
+// values at specific historical offsets from the same kline window
+// (e.g. "EMA12 two bars ago"), not just the final reading graded by
+// IndicatorTask.
+type HistoricalIndicatorTask struct{}
+
+func (HistoricalIndicatorTask) Name() string { return "historical" }
+
+func (HistoricalIndicatorTask) BuildPrompt(klines []market.Kline) string {
+	var sb strings.Builder
+
+	sb.WriteString("Below is the K-line (candlestick) data sorted from oldest to newest:\n")
+	sb.WriteString("Index | Open | High | Low | Close | Volume\n")
+	sb.WriteString("------|------|------|-----|-------|--------\n")
+
+	for i, k := range klines {
+		sb.WriteString(fmt.Sprintf("%d | %.2f | %.2f | %.2f | %.2f | %.2f\n",
+			i+1, k.Open, k.High, k.Low, k.Close, k.Volume))
+	}
+
+	sb.WriteString(fmt.Sprintf(`
+Calculate MA20, EMA12, EMA26, MACD, RSI14, Bollinger Bands (20, 2 std dev), ATR14, and VolumeMA5
+as of each of the following bar offsets from the most recent candlestick above, where offset 0 is
+the most recent bar, offset 1 is the bar before it, and so on: %v
+
+Return ONLY a JSON object in the following format, with no additional text:
+{
+  "offsets": {
+    "0": {"ma20": number, "ema12": number, "ema26": number, "macd": number, "rsi14": number, "boll_upper": number, "boll_middle": number, "boll_lower": number, "atr14": number, "volume_ma5": number},
+    "1": { ... same fields ... }
+  }
+}
+
+Requirements:
+- Include one entry per requested offset, keyed by its offset as a string
+- Round all values to 2 decimal places
+- Return ONLY the JSON object, no explanations`, historicalOffsets))
+
+	return sb.String()
+}
+
+// historicalResponse is the wire shape of a HistoricalIndicatorTask
+// response: one IndicatorResult per requested offset, keyed by offset.
+type historicalResponse struct {
+	Offsets map[string]*IndicatorResult `json:"offsets"`
+}
+
+func (HistoricalIndicatorTask) ParseResponse(response string) (any, error) {
+	var parsed historicalResponse
+
+	if err := json.Unmarshal([]byte(response), &parsed); err != nil {
+		start := strings.Index(response, "{")
+		end := strings.LastIndex(response, "}")
+		if start == -1 || end == -1 || end <= start {
+			return nil, fmt.Errorf("historical task: no JSON found in response")
+		}
+		if err := json.Unmarshal([]byte(response[start:end+1]), &parsed); err != nil {
+			return nil, fmt.Errorf("historical task: parse JSON failed: %w", err)
+		}
+	}
+
+	result := make(map[int]*IndicatorResult, len(parsed.Offsets))
+	for k, v := range parsed.Offsets {
+		offset, err := strconv.Atoi(k)
+		if err != nil {
+			return nil, fmt.Errorf("historical task: non-integer offset key %q", k)
+		}
+		result[offset] = v
+	}
+
+	return result, nil
+}
+
+func (HistoricalIndicatorTask) GroundTruth(klines []market.Kline) any {
+	series := CalculateIndicatorSeries(klines)
+	result := make(map[int]*IndicatorResult, len(historicalOffsets))
+	for _, offset := range historicalOffsets {
+		result[offset] = series.At(offset)
+	}
+	return result
+}
+
+func (HistoricalIndicatorTask) Score(expected, actual any) (map[string]float64, error) {
+	exp, ok := expected.(map[int]*IndicatorResult)
+	if !ok {
+		return nil, fmt.Errorf("historical task: expected map[int]*IndicatorResult, got %T", expected)
+	}
+	act, ok := actual.(map[int]*IndicatorResult)
+	if !ok {
+		return nil, fmt.Errorf("historical task: actual map[int]*IndicatorResult, got %T", actual)
+	}
+
+	scores := make(map[string]float64, len(exp))
+	for offset, expResult := range exp {
+		actResult, ok := act[offset]
+		if !ok {
+			scores[fmt.Sprintf("offset_%d", offset)] = 0
+			continue
+		}
+		offsetScores, _ := ScoreIndicators(expResult, actResult)
+		scores[fmt.Sprintf("offset_%d", offset)] = CalculateTotalScore(offsetScores)
+	}
+
+	return scores, nil
+}
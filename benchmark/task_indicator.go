@@ -0,0 +1,54 @@
+package benchmark
+
+import (
+	"fmt"
+
+	"FinBench/market"
+)
+
+// IndicatorTask grades numerical indicator computation (MA20, EMA12/26,
+// MACD, RSI14, Bollinger Bands, ATR14, VolumeMA5) against values computed
+// locally via the market package. This is FinBench's original behavior.
+type IndicatorTask struct{}
+
+func (IndicatorTask) Name() string { return "indicator" }
+
+func (IndicatorTask) BuildPrompt(klines []market.Kline) string {
+	// Task.BuildPrompt has no per-model Capabilities to consult (it's
+	// shared across every Task, not just this one), so this always takes
+	// the conservative no-native-JSON-mode phrasing.
+	return BuildIndicatorPrompt(klines, Capabilities{})
+}
+
+func (IndicatorTask) ParseResponse(response string) (any, error) {
+	return ParseIndicatorResponse(response)
+}
+
+func (IndicatorTask) GroundTruth(klines []market.Kline) any {
+	return CalculateIndicators(klines)
+}
+
+func (IndicatorTask) Score(expected, actual any) (map[string]float64, error) {
+	exp, ok := expected.(*IndicatorResult)
+	if !ok {
+		return nil, fmt.Errorf("indicator task: expected *IndicatorResult, got %T", expected)
+	}
+	act, ok := actual.(*IndicatorResult)
+	if !ok {
+		return nil, fmt.Errorf("indicator task: actual *IndicatorResult, got %T", actual)
+	}
+
+	scores, _ := ScoreIndicators(exp, act)
+	return map[string]float64{
+		"ma20":        scores.MA20,
+		"ema12":       scores.EMA12,
+		"ema26":       scores.EMA26,
+		"macd":        scores.MACD,
+		"rsi14":       scores.RSI14,
+		"boll_upper":  scores.BOLLUp,
+		"boll_middle": scores.BOLLMid,
+		"boll_lower":  scores.BOLLLow,
+		"atr14":       scores.ATR14,
+		"volume_ma5":  scores.VolumeMA,
+	}, nil
+}
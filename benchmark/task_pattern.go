@@ -0,0 +1,71 @@
+package benchmark
+
+import (
+	"fmt"
+	"strings"
+
+	"FinBench/market"
+)
+
+// PatternTask asks a model to classify the last 20 candles into one of the
+// chart patterns market.DetectPattern recognizes, and scores it as a simple
+// hit/miss against that deterministic ground truth.
+type PatternTask struct{}
+
+func (PatternTask) Name() string { return "pattern" }
+
+func (PatternTask) BuildPrompt(klines []market.Kline) string {
+	var sb strings.Builder
+
+	sb.WriteString("Below is the K-line (candlestick) data sorted from oldest to newest:\n")
+	sb.WriteString("Index | Open | High | Low | Close | Volume\n")
+	sb.WriteString("------|------|------|-----|-------|--------\n")
+
+	for i, k := range klines {
+		sb.WriteString(fmt.Sprintf("%d | %.2f | %.2f | %.2f | %.2f | %.2f\n",
+			i+1, k.Open, k.High, k.Low, k.Close, k.Volume))
+	}
+
+	sb.WriteString("\nClassify the overall shape of the last 20 candlesticks above into exactly one of: double_top, double_bottom, head_shoulders, ascending_triangle, descending_triangle, none.\n")
+	sb.WriteString("Return ONLY the pattern name, with no additional text.")
+
+	return sb.String()
+}
+
+func (PatternTask) ParseResponse(response string) (any, error) {
+	clean := strings.ToLower(strings.TrimSpace(response))
+	for _, p := range []string{
+		market.PatternDoubleTop,
+		market.PatternDoubleBottom,
+		market.PatternHeadShoulders,
+		market.PatternAscendingTriangle,
+		market.PatternDescendingTriangle,
+		market.PatternNone,
+	} {
+		if strings.Contains(clean, p) {
+			return p, nil
+		}
+	}
+	return "", fmt.Errorf("pattern task: could not parse a known pattern from response: %q", response)
+}
+
+func (PatternTask) GroundTruth(klines []market.Kline) any {
+	return market.DetectPattern(klines)
+}
+
+func (PatternTask) Score(expected, actual any) (map[string]float64, error) {
+	exp, ok := expected.(string)
+	if !ok {
+		return nil, fmt.Errorf("pattern task: expected string, got %T", expected)
+	}
+	act, ok := actual.(string)
+	if !ok {
+		return nil, fmt.Errorf("pattern task: actual string, got %T", actual)
+	}
+
+	score := 0.0
+	if exp == act {
+		score = 100.0
+	}
+	return map[string]float64{"pattern_match": score}, nil
+}
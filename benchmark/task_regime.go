@@ -0,0 +1,74 @@
+package benchmark
+
+import (
+	"fmt"
+	"strings"
+
+	"FinBench/market"
+)
+
+// regimePeriod is the ADX/ATR lookback RegimeTask asks models to use,
+// and the period it passes to market.ClassifyRegime for ground truth.
+const regimePeriod = 14
+
+// RegimeTask asks a model to classify the current market regime
+// (trending_up, trending_down, ranging, high_volatility) and scores it as
+// a hit/miss against market.ClassifyRegime's deterministic ADX/ATR rule.
+type RegimeTask struct{}
+
+func (RegimeTask) Name() string { return "regime" }
+
+func (RegimeTask) BuildPrompt(klines []market.Kline) string {
+	var sb strings.Builder
+
+	sb.WriteString("Below is the K-line (candlestick) data sorted from oldest to newest:\n")
+	sb.WriteString("Index | Open | High | Low | Close | Volume\n")
+	sb.WriteString("------|------|------|-----|-------|--------\n")
+
+	for i, k := range klines {
+		sb.WriteString(fmt.Sprintf("%d | %.2f | %.2f | %.2f | %.2f | %.2f\n",
+			i+1, k.Open, k.High, k.Low, k.Close, k.Volume))
+	}
+
+	sb.WriteString("\nClassify the current market regime into exactly one of: trending_up, trending_down, ranging, high_volatility.\n")
+	sb.WriteString(fmt.Sprintf("Base your classification on %d-period trend strength/direction (ADX, +DI/-DI) and volatility (ATR relative to price).\n", regimePeriod))
+	sb.WriteString("Return ONLY the regime name, with no additional text.")
+
+	return sb.String()
+}
+
+func (RegimeTask) ParseResponse(response string) (any, error) {
+	clean := strings.ToLower(strings.TrimSpace(response))
+	for _, r := range []string{
+		market.RegimeTrendingUp,
+		market.RegimeTrendingDown,
+		market.RegimeRanging,
+		market.RegimeHighVolatility,
+	} {
+		if strings.Contains(clean, r) {
+			return r, nil
+		}
+	}
+	return "", fmt.Errorf("regime task: could not parse a known regime from response: %q", response)
+}
+
+func (RegimeTask) GroundTruth(klines []market.Kline) any {
+	return market.ClassifyRegime(klines, regimePeriod)
+}
+
+func (RegimeTask) Score(expected, actual any) (map[string]float64, error) {
+	exp, ok := expected.(string)
+	if !ok {
+		return nil, fmt.Errorf("regime task: expected string, got %T", expected)
+	}
+	act, ok := actual.(string)
+	if !ok {
+		return nil, fmt.Errorf("regime task: actual string, got %T", actual)
+	}
+
+	score := 0.0
+	if exp == act {
+		score = 100.0
+	}
+	return map[string]float64{"regime_match": score}, nil
+}
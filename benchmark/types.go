@@ -30,6 +30,40 @@ type IndicatorResult struct {
 	VolumeMA float64 `json:"volume_ma5"`
 }
 
+// IndicatorSeriesSet holds the full per-kline trajectory of each indicator
+// in IndicatorResult, so historical offsets (e.g. "EMA12 two bars ago")
+// can be read via market.IndicatorSeries.Last instead of only the final
+// reading.
+type IndicatorSeriesSet struct {
+	MA20     market.IndicatorSeries
+	EMA12    market.IndicatorSeries
+	EMA26    market.IndicatorSeries
+	MACD     market.IndicatorSeries
+	RSI14    market.IndicatorSeries
+	BOLLUp   market.IndicatorSeries
+	BOLLMid  market.IndicatorSeries
+	BOLLLow  market.IndicatorSeries
+	ATR14    market.IndicatorSeries
+	VolumeMA market.IndicatorSeries
+}
+
+// At returns the IndicatorResult offset bars back from the most recent
+// bar, reading each field from its series via IndicatorSeries.Last.
+func (s *IndicatorSeriesSet) At(offset int) *IndicatorResult {
+	return &IndicatorResult{
+		MA20:     s.MA20.Last(offset),
+		EMA12:    s.EMA12.Last(offset),
+		EMA26:    s.EMA26.Last(offset),
+		MACD:     s.MACD.Last(offset),
+		RSI14:    s.RSI14.Last(offset),
+		BOLLUp:   s.BOLLUp.Last(offset),
+		BOLLMid:  s.BOLLMid.Last(offset),
+		BOLLLow:  s.BOLLLow.Last(offset),
+		ATR14:    s.ATR14.Last(offset),
+		VolumeMA: s.VolumeMA.Last(offset),
+	}
+}
+
 // IndicatorScores holds scores for each indicator
 type IndicatorScores struct {
 	MA20     float64 `json:"ma20"`
@@ -58,17 +92,93 @@ type BenchResult struct {
 	Latency    time.Duration      `json:"latency"`
 	RawOutput  string             `json:"raw_output"`
 	Error      string             `json:"error,omitempty"`
+
+	// Streaming-only fields, populated when BenchConfig.Streaming is true
+	// and the provider supports ChatStream. They let us tell a model that
+	// is slow-but-steady apart from one that stalls then dumps a response.
+	TimeToFirstTokenMs float64 `json:"time_to_first_token_ms,omitempty"`
+	TokensPerSecond    float64 `json:"tokens_per_second,omitempty"`
+	PromptTokens       int     `json:"prompt_tokens,omitempty"`
+	CompletionTokens   int     `json:"completion_tokens,omitempty"`
+
+	// Cost accounting, populated from the provider-reported token usage
+	// (see UsageReporter) priced against the Pricing table.
+	InputTokens  int     `json:"input_tokens,omitempty"`
+	OutputTokens int     `json:"output_tokens,omitempty"`
+	CostUSD      float64 `json:"cost_usd,omitempty"`
 }
 
 // BenchConfig holds benchmark configuration
 type BenchConfig struct {
-	Mode       string        `json:"mode"`        // "static" | "realtime"
-	DatasetDir string        `json:"dataset_dir"` // For static mode
+	Mode       string        `json:"mode"`        // "static" | "realtime" | "conformance"
+	DatasetDir string        `json:"dataset_dir"` // For static mode; corpus dir (containing vectors.json) for conformance mode
 	Symbols    []string      `json:"symbols"`     // For realtime mode
 	Interval   string        `json:"interval"`    // K-line interval
 	KlineCount int           `json:"kline_count"` // Number of K-lines
 	Models     []ModelConfig `json:"models"`
 	Runs       int           `json:"runs"` // Number of runs per model for statistical analysis
+
+	// MetricsAddr, if set, starts an HTTP server exposing /debug/pprof/*
+	// and a Prometheus /metrics endpoint for the duration of Run. Useful
+	// for diagnosing overnight multi-run benchmarks against several
+	// providers (which one is hanging, which is rate-limiting, where CPU
+	// time is going while parsing large RawOutput strings).
+	MetricsAddr string `json:"metrics_addr,omitempty"`
+	// CPUProfilePath, if set, writes a pprof CPU profile covering the
+	// entire duration of Run.
+	CPUProfilePath string `json:"cpu_profile_path,omitempty"`
+
+	// Streaming, when true, makes runSingleBenchmark prefer ChatStream
+	// over Chat for providers that implement StreamingClient, so
+	// TimeToFirstTokenMs/TokensPerSecond can be measured. It applies only
+	// while CacheMode is off; with a cache mode set, calls go through the
+	// cache and Chat instead.
+	Streaming bool `json:"streaming,omitempty"`
+
+	// Tasks selects which registered Task names (see GetTask) to run per
+	// snapshot, in addition to the original indicator benchmark. Empty
+	// means indicator-only, preserving existing behavior.
+	Tasks []string `json:"tasks,omitempty"`
+
+	// PricingPath points at a pricing YAML file (see LoadPricingTable);
+	// defaults to configs/pricing.yaml when empty.
+	PricingPath string `json:"pricing_path,omitempty"`
+	// BudgetUSD, if set, makes Run abort the sweep (returning the partial
+	// report built so far) once the projected cost of the remaining runs
+	// would push total spend past this amount.
+	BudgetUSD float64 `json:"budget_usd,omitempty"`
+
+	// StreamProvider selects a market/stream.StreamSource ("binance",
+	// "binance-futures", "okx", or "coinbase") for Mode == "realtime".
+	// Empty falls back to the original one-shot CaptureSnapshot poll.
+	StreamProvider string `json:"stream_provider,omitempty"`
+	// StreamDuration bounds how long a streaming realtime run listens for
+	// closed bars before returning its report. Zero means run until ctx
+	// is cancelled.
+	StreamDuration time.Duration `json:"stream_duration,omitempty"`
+
+	// CacheMode selects how runSingleBenchmark uses the llm/cache
+	// content-addressed response cache: "off" (default) calls providers
+	// directly, "read-through" serves cache hits and stores misses, and
+	// "replay-only" serves cache hits and errors on a miss so a replayed
+	// sweep never spends API credits.
+	CacheMode string `json:"cache_mode,omitempty"`
+	// CacheDir overrides where the cache store lives; empty uses
+	// cache.DefaultDir() (~/.finbench/cache).
+	CacheDir string `json:"cache_dir,omitempty"`
+	// CacheMaxBytes caps the cache store's on-disk size, evicting
+	// least-recently-used entries first. 0 leaves it unbounded.
+	CacheMaxBytes int64 `json:"cache_max_bytes,omitempty"`
+
+	// ReportsDir, if set, makes Run save the completed report there (see
+	// SaveReport) so it can later be reloaded by ID for RescoreReport.
+	// Empty disables report persistence.
+	ReportsDir string `json:"reports_dir,omitempty"`
+
+	// Scoring selects how indicator answers are scored (see
+	// ScoringPolicy). The zero value scores percent-error only,
+	// preserving existing behavior.
+	Scoring ScoringPolicy `json:"scoring,omitempty"`
 }
 
 // ModelConfig holds configuration for a single LLM
@@ -91,6 +201,15 @@ type BenchReport struct {
 	Results     []*BenchResult     `json:"results"`
 	Statistics  []*ModelStatistics `json:"statistics"`
 	Leaderboard []LeaderboardEntry `json:"leaderboard"`
+	// CorpusVersion is set when Config.Mode == "conformance" and pins the
+	// report to a specific vectors.json corpus so reports can only be
+	// diffed against others graded on the same ground truth.
+	CorpusVersion string `json:"corpus_version,omitempty"`
+	// ResultsByTask holds results for each Task named in Config.Tasks
+	// (including "indicator", if named), keyed by Task.Name(). The
+	// original indicator results
+	// stay in Results/Statistics/Leaderboard unchanged.
+	ResultsByTask map[string][]*TaskResult `json:"results_by_task,omitempty"`
 }
 
 // EnvironmentInfo holds information about the benchmark environment
@@ -104,20 +223,29 @@ type EnvironmentInfo struct {
 
 // ModelStatistics holds statistical analysis for a model across multiple runs
 type ModelStatistics struct {
-	Model         string    `json:"model"`
-	ModelInfo     ModelInfo `json:"model_info"`
-	RunCount      int       `json:"run_count"`
-	SuccessCount  int       `json:"success_count"`
-	FailureCount  int       `json:"failure_count"`
-	AvgScore      float64   `json:"avg_score"`
-	MinScore      float64   `json:"min_score"`
-	MaxScore      float64   `json:"max_score"`
-	StdDev        float64   `json:"std_dev"`
-	AvgLatencyMs  float64   `json:"avg_latency_ms"`
-	MinLatencyMs  float64   `json:"min_latency_ms"`
-	MaxLatencyMs  float64   `json:"max_latency_ms"`
-	Consistency   float64   `json:"consistency"` // 100 - (StdDev / AvgScore * 100)
+	Model         string             `json:"model"`
+	ModelInfo     ModelInfo          `json:"model_info"`
+	RunCount      int                `json:"run_count"`
+	SuccessCount  int                `json:"success_count"`
+	FailureCount  int                `json:"failure_count"`
+	AvgScore      float64            `json:"avg_score"`
+	MinScore      float64            `json:"min_score"`
+	MaxScore      float64            `json:"max_score"`
+	StdDev        float64            `json:"std_dev"`
+	AvgLatencyMs  float64            `json:"avg_latency_ms"`
+	MinLatencyMs  float64            `json:"min_latency_ms"`
+	MaxLatencyMs  float64            `json:"max_latency_ms"`
+	LatencyP50Ms  float64            `json:"latency_p50_ms"`
+	LatencyP95Ms  float64            `json:"latency_p95_ms"`
+	LatencyP99Ms  float64            `json:"latency_p99_ms"`
+	Consistency   float64            `json:"consistency"` // 100 - (StdDev / AvgScore * 100)
 	IndicatorAvgs map[string]float64 `json:"indicator_avgs"`
+
+	// TotalCostUSD sums CostUSD across this model's runs; CostPerScorePoint
+	// is TotalCostUSD / AvgScore, the $/quality tradeoff the leaderboard's
+	// cost-efficiency rank is sorted by.
+	TotalCostUSD      float64 `json:"total_cost_usd,omitempty"`
+	CostPerScorePoint float64 `json:"cost_per_score_point,omitempty"`
 }
 
 // LeaderboardEntry represents a model's ranking
@@ -130,5 +258,12 @@ type LeaderboardEntry struct {
 	StdDev      float64 `json:"std_dev"`
 	Consistency float64 `json:"consistency"`
 	AvgLatency  float64 `json:"avg_latency_ms"`
+	LatencyP95  float64 `json:"latency_p95_ms"`
 	RunCount    int     `json:"run_count"`
+
+	// CostPerScorePoint and CostEfficiencyRank surface the $/quality
+	// tradeoff alongside raw accuracy; rank 0 means no pricing data was
+	// available for this model.
+	CostPerScorePoint  float64 `json:"cost_per_score_point,omitempty"`
+	CostEfficiencyRank int     `json:"cost_efficiency_rank,omitempty"`
 }
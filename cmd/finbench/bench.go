@@ -0,0 +1,45 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	"FinBench/benchmark"
+)
+
+func runBench(args []string) error {
+	fs := flag.NewFlagSet("bench", flag.ExitOnError)
+	fromReport := fs.String("from-report", "", "rescore a previously saved report by ID instead of running a new sweep")
+	reportsDir := fs.String("reports-dir", "reports", "directory SaveReport/LoadReport read and write reports from")
+	out := fs.String("out", "", "write the (re)scored report as JSON to this path instead of stdout")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if *fromReport == "" {
+		return fmt.Errorf("bench: only --from-report is supported from the CLI; build a BenchConfig and call benchmark.NewEngine(...).Run for a live sweep")
+	}
+
+	report, err := benchmark.LoadReport(*reportsDir, *fromReport)
+	if err != nil {
+		return fmt.Errorf("load report %s: %w", *fromReport, err)
+	}
+
+	rescored, err := benchmark.RescoreReport(report)
+	if err != nil {
+		return fmt.Errorf("rescore report %s: %w", *fromReport, err)
+	}
+
+	data, err := json.MarshalIndent(rescored, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal rescored report: %w", err)
+	}
+
+	if *out == "" {
+		fmt.Println(string(data))
+		return nil
+	}
+	return os.WriteFile(*out, data, 0644)
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+
+	"FinBench/benchmark"
+)
+
+// runConformance dispatches the "finbench conformance <sub>" commands.
+func runConformance(args []string) error {
+	if len(args) < 1 {
+		return fmt.Errorf("usage: finbench conformance diff <reportA> <reportB>")
+	}
+
+	switch args[0] {
+	case "diff":
+		if len(args) != 3 {
+			return fmt.Errorf("usage: finbench conformance diff <reportA> <reportB>")
+		}
+		return conformanceDiff(args[1], args[2])
+	default:
+		return fmt.Errorf("unknown conformance subcommand: %s", args[0])
+	}
+}
+
+// conformanceDelta is the score change for one model's indicator between
+// two reports graded against the same conformance corpus version.
+type conformanceDelta struct {
+	Model     string  `json:"model"`
+	Indicator string  `json:"indicator"`
+	ScoreA    float64 `json:"score_a"`
+	ScoreB    float64 `json:"score_b"`
+	Change    float64 `json:"change"`
+}
+
+func conformanceDiff(pathA, pathB string) error {
+	reportA, err := loadReport(pathA)
+	if err != nil {
+		return fmt.Errorf("load %s: %w", pathA, err)
+	}
+	reportB, err := loadReport(pathB)
+	if err != nil {
+		return fmt.Errorf("load %s: %w", pathB, err)
+	}
+
+	if reportA.CorpusVersion == "" || reportB.CorpusVersion == "" {
+		return fmt.Errorf("both reports must carry a corpus_version to be comparable")
+	}
+	if reportA.CorpusVersion != reportB.CorpusVersion {
+		return fmt.Errorf("corpus version mismatch: %s vs %s", reportA.CorpusVersion, reportB.CorpusVersion)
+	}
+
+	avgA := indicatorAveragesByModel(reportA)
+	avgB := indicatorAveragesByModel(reportB)
+
+	var deltas []conformanceDelta
+	for model, indicatorsA := range avgA {
+		indicatorsB := avgB[model]
+		for indicator, scoreA := range indicatorsA {
+			scoreB := indicatorsB[indicator]
+			deltas = append(deltas, conformanceDelta{
+				Model:     model,
+				Indicator: indicator,
+				ScoreA:    scoreA,
+				ScoreB:    scoreB,
+				Change:    scoreB - scoreA,
+			})
+		}
+	}
+
+	sort.Slice(deltas, func(i, j int) bool {
+		if deltas[i].Model != deltas[j].Model {
+			return deltas[i].Model < deltas[j].Model
+		}
+		return deltas[i].Indicator < deltas[j].Indicator
+	})
+
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(deltas)
+}
+
+func loadReport(path string) (*benchmark.BenchReport, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var report benchmark.BenchReport
+	if err := json.Unmarshal(data, &report); err != nil {
+		return nil, err
+	}
+	return &report, nil
+}
+
+// indicatorAveragesByModel pulls the per-indicator averages already computed
+// in each model's statistics, keyed by model name.
+func indicatorAveragesByModel(report *benchmark.BenchReport) map[string]map[string]float64 {
+	out := make(map[string]map[string]float64, len(report.Statistics))
+	for _, stat := range report.Statistics {
+		out[stat.Model] = stat.IndicatorAvgs
+	}
+	return out
+}
@@ -0,0 +1,26 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"net/http"
+
+	"FinBench/dashboard"
+)
+
+func runDashboard(args []string) error {
+	fs := flag.NewFlagSet("dashboard", flag.ExitOnError)
+	historyPath := fs.String("history", "dashboard_history.jsonl", "path to the dashboard history store")
+	addr := fs.String("addr", ":8090", "address to serve the dashboard on")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	store, err := dashboard.NewStore(*historyPath)
+	if err != nil {
+		return fmt.Errorf("open dashboard store: %w", err)
+	}
+
+	fmt.Printf("serving dashboard on %s (history: %s)\n", *addr, *historyPath)
+	return http.ListenAndServe(*addr, dashboard.NewHandler(store))
+}
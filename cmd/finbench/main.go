@@ -0,0 +1,41 @@
+// Command finbench is the FinBench CLI entry point.
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(1)
+	}
+
+	var err error
+	switch os.Args[1] {
+	case "conformance":
+		err = runConformance(os.Args[2:])
+	case "dashboard":
+		err = runDashboard(os.Args[2:])
+	case "bench":
+		err = runBench(os.Args[2:])
+	default:
+		usage()
+		os.Exit(1)
+	}
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "finbench: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, `usage: finbench <command> [arguments]
+
+Commands:
+  conformance diff <reportA> <reportB>   compare two conformance BenchReports
+  dashboard [-history path] [-addr addr]   serve the regression-tracking dashboard
+  bench --from-report <id> [-reports-dir dir] [-out path]   rescore a saved report from its stored RawOutput`)
+}
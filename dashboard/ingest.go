@@ -0,0 +1,49 @@
+package dashboard
+
+import "FinBench/benchmark"
+
+// ScoreIndicator and LatencyIndicator are the pseudo-indicator names used
+// for the per-model aggregate score and average latency series,
+// alongside the real per-indicator averages already in
+// ModelStatistics.IndicatorAvgs.
+const (
+	ScoreIndicator   = "score"
+	LatencyIndicator = "latency_ms"
+)
+
+// RecordsFromReport flattens a BenchReport's per-model statistics into
+// Records suitable for Store.Append.
+func RecordsFromReport(report *benchmark.BenchReport) []Record {
+	var records []Record
+
+	for _, stat := range report.Statistics {
+		records = append(records,
+			Record{
+				Model:      stat.Model,
+				Indicator:  ScoreIndicator,
+				Timestamp:  report.Timestamp,
+				Value:      stat.AvgScore,
+				CommitHash: report.ID,
+			},
+			Record{
+				Model:      stat.Model,
+				Indicator:  LatencyIndicator,
+				Timestamp:  report.Timestamp,
+				Value:      stat.AvgLatencyMs,
+				CommitHash: report.ID,
+			},
+		)
+
+		for indicator, avg := range stat.IndicatorAvgs {
+			records = append(records, Record{
+				Model:      stat.Model,
+				Indicator:  indicator,
+				Timestamp:  report.Timestamp,
+				Value:      avg,
+				CommitHash: report.ID,
+			})
+		}
+	}
+
+	return records
+}
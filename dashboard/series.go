@@ -0,0 +1,110 @@
+package dashboard
+
+import "sort"
+
+// defaultWindow is how many trailing runs a Point's band is computed over
+// when BuildSeries isn't given an explicit window.
+const defaultWindow = 5
+
+// Point is one plotted position in a Series: Center is the window median,
+// Low/High the p25/p75 band around it.
+type Point struct {
+	CommitHash string  `json:"CommitHash"`
+	CommitDate string  `json:"CommitDate"`
+	Low        float64 `json:"Low"`
+	Center     float64 `json:"Center"`
+	High       float64 `json:"High"`
+}
+
+// Series is one (model, indicator) trendline, band-chart ready.
+type Series struct {
+	Name   string  `json:"Name"`
+	Unit   string  `json:"Unit"`
+	Values []Point `json:"Values"`
+}
+
+// seriesUnit returns the display unit for an indicator name.
+func seriesUnit(indicator string) string {
+	if indicator == LatencyIndicator {
+		return "ms"
+	}
+	return "score"
+}
+
+// BuildSeries filters records to (model, indicator) and computes, for
+// every point, the median and p25/p75 band over the trailing window runs
+// ending at that point (including it). window <= 0 uses defaultWindow.
+func BuildSeries(records []Record, model, indicator string, window int) Series {
+	if window <= 0 {
+		window = defaultWindow
+	}
+
+	var matched []Record
+	for _, r := range records {
+		if r.Model == model && r.Indicator == indicator {
+			matched = append(matched, r)
+		}
+	}
+	sort.Slice(matched, func(i, j int) bool { return matched[i].Timestamp.Before(matched[j].Timestamp) })
+
+	series := Series{
+		Name: model + "/" + indicator,
+		Unit: seriesUnit(indicator),
+	}
+
+	for i, r := range matched {
+		from := i - window + 1
+		if from < 0 {
+			from = 0
+		}
+		windowValues := make([]float64, 0, i-from+1)
+		for _, w := range matched[from : i+1] {
+			windowValues = append(windowValues, w.Value)
+		}
+
+		series.Values = append(series.Values, Point{
+			CommitHash: r.CommitHash,
+			CommitDate: r.Timestamp.Format("2006-01-02T15:04:05Z07:00"),
+			Low:        percentile(windowValues, 25),
+			Center:     percentile(windowValues, 50),
+			High:       percentile(windowValues, 75),
+		})
+	}
+
+	return series
+}
+
+// percentile returns the p-th percentile (0-100) of values using
+// linear interpolation between the two nearest ranks.
+func percentile(values []float64, p float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+
+	sorted := make([]float64, len(values))
+	copy(sorted, values)
+	sort.Float64s(sorted)
+
+	rank := (p / 100) * float64(len(sorted)-1)
+	lo := int(rank)
+	hi := lo + 1
+	if hi >= len(sorted) {
+		return sorted[lo]
+	}
+	frac := rank - float64(lo)
+	return sorted[lo] + (sorted[hi]-sorted[lo])*frac
+}
+
+// Models returns the distinct model names present in records.
+func Models(records []Record) []string {
+	seen := make(map[string]bool)
+	var models []string
+	for _, r := range records {
+		if !seen[r.Model] {
+			seen[r.Model] = true
+			models = append(models, r.Model)
+		}
+	}
+	sort.Strings(models)
+	return models
+}
@@ -0,0 +1,108 @@
+package dashboard
+
+import (
+	_ "embed"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+//go:embed assets/dashboard.html
+var dashboardHTML []byte
+
+//go:embed assets/bandchart.js
+var bandChartJS []byte
+
+// NewHandler serves the dashboard at /dashboard/, its data feed at
+// /dashboard/data.json, and a multi-model overlay view at
+// /dashboard/compare.
+func NewHandler(store *Store) http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/dashboard/", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/dashboard/" && r.URL.Path != "/dashboard" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Write(dashboardHTML)
+	})
+
+	mux.HandleFunc("/dashboard/bandchart.js", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/javascript; charset=utf-8")
+		w.Write(bandChartJS)
+	})
+
+	mux.HandleFunc("/dashboard/data.json", handleData(store))
+	mux.HandleFunc("/dashboard/compare", handleCompare(store))
+
+	return mux
+}
+
+// handleData serves a single (model, indicator) Series as JSON, read
+// from the ?model=&indicator=&window= query parameters.
+func handleData(store *Store) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		records, err := store.All()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		model := r.URL.Query().Get("model")
+		indicator := r.URL.Query().Get("indicator")
+		if indicator == "" {
+			indicator = ScoreIndicator
+		}
+		if model == "" {
+			writeJSON(w, map[string]any{"models": Models(records)})
+			return
+		}
+
+		window, _ := strconv.Atoi(r.URL.Query().Get("window"))
+		series := BuildSeries(records, model, indicator, window)
+		writeJSON(w, series)
+	}
+}
+
+// handleCompare overlays the same indicator's Series for several models,
+// given as a comma-separated ?models=a,b,c query parameter.
+func handleCompare(store *Store) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		records, err := store.All()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		modelsParam := r.URL.Query().Get("models")
+		if modelsParam == "" {
+			http.Error(w, "missing models query parameter", http.StatusBadRequest)
+			return
+		}
+		indicator := r.URL.Query().Get("indicator")
+		if indicator == "" {
+			indicator = ScoreIndicator
+		}
+		window, _ := strconv.Atoi(r.URL.Query().Get("window"))
+
+		var result []Series
+		for _, model := range strings.Split(modelsParam, ",") {
+			model = strings.TrimSpace(model)
+			if model == "" {
+				continue
+			}
+			result = append(result, BuildSeries(records, model, indicator, window))
+		}
+
+		writeJSON(w, result)
+	}
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
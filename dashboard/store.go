@@ -0,0 +1,117 @@
+// Package dashboard persists FinBench run history to a local store and
+// serves a regression-tracking dashboard over it, so score/latency drift
+// across FinBench versions and model releases can be read as trendlines
+// instead of re-diffing one-shot reports by hand.
+//
+// dashboard deliberately does not import benchmark's Engine: whatever
+// already calls Engine.Run is expected to feed the resulting
+// *benchmark.BenchReport through RecordsFromReport and Store.Append
+// itself, the same way it already owns assembling the BenchConfig passed
+// into Run.
+package dashboard
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Record is one (model, indicator, timestamp) observation. CommitHash
+// identifies the FinBench run that produced it (BenchReport.ID, in the
+// absence of FinBench being built with real VCS build info).
+type Record struct {
+	Model      string    `json:"model"`
+	Indicator  string    `json:"indicator"`
+	Timestamp  time.Time `json:"timestamp"`
+	Value      float64   `json:"value"`
+	CommitHash string    `json:"commit_hash"`
+}
+
+// Store is an append-only JSON-lines history of Records on disk, matching
+// the flat-file persistence style benchmark.SaveSnapshot/LoadSnapshots
+// already use rather than pulling in a database engine.
+type Store struct {
+	path string
+	mu   sync.Mutex
+}
+
+// NewStore opens (creating if necessary) a Store backed by the JSON-lines
+// file at path.
+func NewStore(path string) (*Store, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, fmt.Errorf("create store directory: %w", err)
+	}
+	return &Store{path: path}, nil
+}
+
+// Append adds records to the store.
+func (s *Store) Append(records []Record) error {
+	if len(records) == 0 {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f, err := os.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("open store: %w", err)
+	}
+	defer f.Close()
+
+	w := bufio.NewWriter(f)
+	for _, r := range records {
+		data, err := json.Marshal(r)
+		if err != nil {
+			return fmt.Errorf("marshal record: %w", err)
+		}
+		if _, err := w.Write(append(data, '\n')); err != nil {
+			return fmt.Errorf("write record: %w", err)
+		}
+	}
+	return w.Flush()
+}
+
+// All returns every record in the store, sorted by Timestamp ascending.
+func (s *Store) All() ([]Record, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f, err := os.Open(s.path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("open store: %w", err)
+	}
+	defer f.Close()
+
+	var records []Record
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+		var r Record
+		if err := json.Unmarshal(line, &r); err != nil {
+			return nil, fmt.Errorf("unmarshal record: %w", err)
+		}
+		records = append(records, r)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read store: %w", err)
+	}
+
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].Timestamp.Before(records[j].Timestamp)
+	})
+
+	return records, nil
+}
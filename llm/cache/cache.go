@@ -0,0 +1,90 @@
+// Package cache is a content-addressed, on-disk cache for LLM responses.
+// It lets a benchmark sweep be replayed deterministically from previously
+// recorded responses instead of re-querying providers, so a report can be
+// re-scored (new ScoreFromError tiers, new indicators) without spending
+// API credits, and so flaky providers can be retried without re-paying for
+// the calls that already succeeded.
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Mode selects how a cache-wrapped call behaves on lookup.
+type Mode string
+
+const (
+	// ModeOff bypasses the cache entirely: every call goes to the
+	// provider, and nothing is recorded.
+	ModeOff Mode = "off"
+	// ModeReadThrough serves a cache hit's Entry directly; on a miss it
+	// calls through to the provider and stores the result.
+	ModeReadThrough Mode = "read-through"
+	// ModeReplayOnly serves a cache hit's Entry directly; on a miss it
+	// returns an error instead of calling the provider, so a replayed
+	// sweep can never silently spend API credits.
+	ModeReplayOnly Mode = "replay-only"
+)
+
+// Key identifies one cacheable model call. Two calls that would produce
+// the same prompt for the same model against the same snapshot hash to
+// the same entry, regardless of when they were made.
+type Key struct {
+	Provider   string
+	Model      string
+	Prompt     string
+	SnapshotID string
+	// BizParams carries provider-specific call parameters (e.g.
+	// QwenAgent.ChatWithBizParams's bizParams) that affect the response
+	// but aren't part of Prompt. Nil for callers that don't use it.
+	BizParams map[string]interface{}
+}
+
+// Hash returns the content address for Key: a hex SHA-256 digest of a
+// canonical JSON encoding (map keys sorted) so the same logical call
+// always hashes the same way.
+func (k Key) Hash() string {
+	canonical := struct {
+		Provider   string                 `json:"provider"`
+		Model      string                 `json:"model"`
+		Prompt     string                 `json:"prompt"`
+		SnapshotID string                 `json:"snapshot_id"`
+		BizParams  map[string]interface{} `json:"biz_params,omitempty"`
+	}{k.Provider, k.Model, k.Prompt, k.SnapshotID, k.BizParams}
+
+	// encoding/json sorts map keys when marshaling, so BizParams encodes
+	// canonically without extra work here.
+	data, err := json.Marshal(canonical)
+	if err != nil {
+		// Key fields are all JSON-marshalable primitives/maps; this would
+		// only fail on an unmarshalable BizParams value.
+		panic(fmt.Sprintf("cache: marshal key: %v", err))
+	}
+
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// Entry is one cached response.
+type Entry struct {
+	Response         string        `json:"response"`
+	Latency          time.Duration `json:"latency"`
+	PromptTokens     int           `json:"prompt_tokens,omitempty"`
+	CompletionTokens int           `json:"completion_tokens,omitempty"`
+}
+
+// DefaultDir returns ~/.finbench/cache, falling back to a relative
+// .finbench/cache if the home directory can't be resolved.
+func DefaultDir() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return filepath.Join(".finbench", "cache")
+	}
+	return filepath.Join(home, ".finbench", "cache")
+}
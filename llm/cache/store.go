@@ -0,0 +1,142 @@
+package cache
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+// defaultMaxBytes is the cap a non-positive maxBytes stands for: 0, no
+// cap. NewStore does not read it; it stores maxBytes as given, and Put
+// only evicts when maxBytes > 0.
+const defaultMaxBytes = 0
+
+// Store is an on-disk, content-addressed key/value store of Entries, one
+// file per key under dir. There's no separate index: eviction walks the
+// directory and uses each file's mtime as its LRU recency, bumped on
+// every Get as well as every Put, since a plain file tree is simpler to
+// inspect and back up than a pebble/badger-style log-structured KV for
+// the access pattern here (whole-entry reads and writes, no partial
+// updates, no range scans).
+type Store struct {
+	dir      string
+	maxBytes int64
+	mu       sync.Mutex
+}
+
+// NewStore opens (creating if necessary) a Store backed by dir. A
+// maxBytes <= 0 leaves the cache unbounded.
+func NewStore(dir string, maxBytes int64) (*Store, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("create cache directory: %w", err)
+	}
+	return &Store{dir: dir, maxBytes: maxBytes}, nil
+}
+
+func (s *Store) path(hash string) string {
+	return filepath.Join(s.dir, hash+".json")
+}
+
+// Get returns the cached Entry for key, if present.
+func (s *Store) Get(key Key) (*Entry, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p := s.path(key.Hash())
+	data, err := os.ReadFile(p)
+	if os.IsNotExist(err) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, fmt.Errorf("read cache entry: %w", err)
+	}
+
+	var entry Entry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, false, fmt.Errorf("unmarshal cache entry: %w", err)
+	}
+
+	// Bump mtime so a hit counts as recent use for LRU eviction.
+	now := time.Now()
+	_ = os.Chtimes(p, now, now)
+
+	return &entry, true, nil
+}
+
+// Put stores entry under key, evicting the least-recently-used entries
+// first if the store is over its size cap afterwards.
+func (s *Store) Put(key Key, entry *Entry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("marshal cache entry: %w", err)
+	}
+
+	if err := os.WriteFile(s.path(key.Hash()), data, 0644); err != nil {
+		return fmt.Errorf("write cache entry: %w", err)
+	}
+
+	if s.maxBytes > 0 {
+		if err := s.evictLocked(); err != nil {
+			return fmt.Errorf("evict cache entries: %w", err)
+		}
+	}
+	return nil
+}
+
+// evictLocked removes least-recently-used entries (oldest mtime first)
+// until the store's total size is at or under maxBytes. Callers must
+// hold s.mu.
+func (s *Store) evictLocked() error {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return err
+	}
+
+	type fileInfo struct {
+		path    string
+		size    int64
+		modTime int64
+	}
+	var files []fileInfo
+	var total int64
+	for _, e := range entries {
+		if e.IsDir() {
+			continue
+		}
+		info, err := e.Info()
+		if err != nil {
+			continue
+		}
+		fi := fileInfo{
+			path:    filepath.Join(s.dir, e.Name()),
+			size:    info.Size(),
+			modTime: info.ModTime().UnixNano(),
+		}
+		files = append(files, fi)
+		total += fi.size
+	}
+
+	if total <= s.maxBytes {
+		return nil
+	}
+
+	sort.Slice(files, func(i, j int) bool { return files[i].modTime < files[j].modTime })
+
+	for _, f := range files {
+		if total <= s.maxBytes {
+			break
+		}
+		if err := os.Remove(f.path); err != nil {
+			return err
+		}
+		total -= f.size
+	}
+	return nil
+}
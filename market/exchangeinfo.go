@@ -0,0 +1,54 @@
+package market
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"FinBench/provider/coinank/coinank_api"
+	"FinBench/provider/coinank/coinank_enum"
+)
+
+// TickInfo holds a symbol's minimum meaningful price/amount increments,
+// used to judge whether a model's answer is "close enough" in units the
+// exchange itself would consider equal, rather than in raw percent error
+// (see ScoringPolicy).
+type TickInfo struct {
+	PriceTickSize  float64
+	AmountTickSize float64
+}
+
+var (
+	exchangeInfoMu    sync.Mutex
+	exchangeInfoCache = make(map[string]*TickInfo)
+)
+
+// GetExchangeInfo returns the PriceTickSize/AmountTickSize for symbol,
+// fetching from CoinAnk on first use and caching the result for the
+// lifetime of the process (tick sizes change rarely enough that a
+// per-process cache, with no TTL, is the right tradeoff here).
+func GetExchangeInfo(symbol string) (*TickInfo, error) {
+	exchangeInfoMu.Lock()
+	if info, ok := exchangeInfoCache[symbol]; ok {
+		exchangeInfoMu.Unlock()
+		return info, nil
+	}
+	exchangeInfoMu.Unlock()
+
+	ctx := context.Background()
+	raw, err := coinank_api.ExchangeInfo(ctx, symbol, coinank_enum.Binance)
+	if err != nil {
+		return nil, fmt.Errorf("CoinAnk API error: %w", err)
+	}
+
+	info := &TickInfo{
+		PriceTickSize:  raw.PriceTickSize,
+		AmountTickSize: raw.AmountTickSize,
+	}
+
+	exchangeInfoMu.Lock()
+	exchangeInfoCache[symbol] = info
+	exchangeInfoMu.Unlock()
+
+	return info, nil
+}
@@ -158,6 +158,83 @@ func CalculateBOLL(klines []Kline, period int, multiplier float64) (upper, middl
 	return upper, middle, lower
 }
 
+// CalculateADX calculates the Average Directional Index using Wilder
+// smoothing, returning the ADX value along with the +DI/-DI lines it was
+// derived from (useful for regime classification beyond the raw strength
+// reading).
+func CalculateADX(klines []Kline, period int) (adx, plusDI, minusDI float64) {
+	if len(klines) <= period*2 {
+		return 0, 0, 0
+	}
+
+	n := len(klines)
+	trs := make([]float64, n)
+	plusDMs := make([]float64, n)
+	minusDMs := make([]float64, n)
+
+	for i := 1; i < n; i++ {
+		high, low := klines[i].High, klines[i].Low
+		prevHigh, prevLow, prevClose := klines[i-1].High, klines[i-1].Low, klines[i-1].Close
+
+		tr1 := high - low
+		tr2 := math.Abs(high - prevClose)
+		tr3 := math.Abs(low - prevClose)
+		trs[i] = math.Max(tr1, math.Max(tr2, tr3))
+
+		upMove := high - prevHigh
+		downMove := prevLow - low
+
+		if upMove > downMove && upMove > 0 {
+			plusDMs[i] = upMove
+		}
+		if downMove > upMove && downMove > 0 {
+			minusDMs[i] = downMove
+		}
+	}
+
+	// Wilder smoothing of TR/+DM/-DM, seeded with the first `period` sums.
+	smoothedTR := sumRange(trs, 1, period+1)
+	smoothedPlusDM := sumRange(plusDMs, 1, period+1)
+	smoothedMinusDM := sumRange(minusDMs, 1, period+1)
+
+	var dxSum float64
+	var dxCount int
+
+	for i := period + 1; i < n; i++ {
+		smoothedTR = smoothedTR - smoothedTR/float64(period) + trs[i]
+		smoothedPlusDM = smoothedPlusDM - smoothedPlusDM/float64(period) + plusDMs[i]
+		smoothedMinusDM = smoothedMinusDM - smoothedMinusDM/float64(period) + minusDMs[i]
+
+		if smoothedTR == 0 {
+			continue
+		}
+		plusDI = 100 * smoothedPlusDM / smoothedTR
+		minusDI = 100 * smoothedMinusDM / smoothedTR
+
+		diSum := plusDI + minusDI
+		if diSum == 0 {
+			continue
+		}
+		dx := 100 * math.Abs(plusDI-minusDI) / diSum
+		dxSum += dx
+		dxCount++
+	}
+
+	if dxCount == 0 {
+		return 0, plusDI, minusDI
+	}
+
+	return dxSum / float64(dxCount), plusDI, minusDI
+}
+
+func sumRange(values []float64, from, to int) float64 {
+	sum := 0.0
+	for i := from; i < to && i < len(values); i++ {
+		sum += values[i]
+	}
+	return sum
+}
+
 // CalculateVolumeMA calculates Volume Moving Average
 func CalculateVolumeMA(klines []Kline, period int) float64 {
 	if len(klines) < period {
@@ -0,0 +1,168 @@
+package market
+
+import "math"
+
+const (
+	PatternDoubleTop          = "double_top"
+	PatternDoubleBottom       = "double_bottom"
+	PatternHeadShoulders      = "head_shoulders"
+	PatternAscendingTriangle  = "ascending_triangle"
+	PatternDescendingTriangle = "descending_triangle"
+	PatternNone               = "none"
+
+	// patternTolerancePct is how close two swing highs/lows must be
+	// (as a percent of price) to be considered "roughly equal" for the
+	// purposes of double top/bottom and triangle flat-side detection.
+	patternTolerancePct = 0.75
+	// patternMinDepthPct is the minimum retracement (as a percent of
+	// price) required between two peaks/troughs for them to count as a
+	// distinct swing rather than noise.
+	patternMinDepthPct = 1.5
+)
+
+// DetectPattern classifies the last 20 candles into one of double_top,
+// double_bottom, head_shoulders, ascending_triangle, descending_triangle,
+// or none. It is a deterministic, rule-based detector (not a learned
+// model) so it can serve as ground truth for PatternTask.
+func DetectPattern(klines []Kline) string {
+	window := klines
+	if len(window) > 20 {
+		window = window[len(window)-20:]
+	}
+	if len(window) < 10 {
+		return PatternNone
+	}
+
+	highs := make([]float64, len(window))
+	lows := make([]float64, len(window))
+	for i, k := range window {
+		highs[i] = k.High
+		lows[i] = k.Low
+	}
+
+	peaks := findSwingPoints(highs, true)
+	troughs := findSwingPoints(lows, false)
+
+	if p := detectHeadShoulders(highs, peaks); p != PatternNone {
+		return p
+	}
+	if p := detectDoubleExtreme(highs, peaks, PatternDoubleTop); p != PatternNone {
+		return p
+	}
+	if p := detectDoubleExtreme(lows, troughs, PatternDoubleBottom); p != PatternNone {
+		return p
+	}
+	if p := detectTriangle(highs, lows); p != PatternNone {
+		return p
+	}
+
+	return PatternNone
+}
+
+// findSwingPoints returns indices of local extrema: maxima when max is
+// true, minima otherwise.
+func findSwingPoints(values []float64, max bool) []int {
+	var idx []int
+	for i := 1; i < len(values)-1; i++ {
+		if max {
+			if values[i] > values[i-1] && values[i] > values[i+1] {
+				idx = append(idx, i)
+			}
+		} else {
+			if values[i] < values[i-1] && values[i] < values[i+1] {
+				idx = append(idx, i)
+			}
+		}
+	}
+	return idx
+}
+
+// roughlyEqual reports whether a and b are within patternTolerancePct
+// percent of one another.
+func roughlyEqual(a, b float64) bool {
+	if a == 0 {
+		return b == 0
+	}
+	return math.Abs(a-b)/math.Abs(a)*100 <= patternTolerancePct
+}
+
+// detectDoubleExtreme looks at the last two swing points of a series and
+// reports whether they form a double top (pattern == PatternDoubleTop on
+// highs) or double bottom (on lows): two roughly-equal extrema separated
+// by a meaningfully deeper pullback between them.
+func detectDoubleExtreme(values []float64, swings []int, pattern string) string {
+	if len(swings) < 2 {
+		return PatternNone
+	}
+
+	a, b := swings[len(swings)-2], swings[len(swings)-1]
+	if !roughlyEqual(values[a], values[b]) {
+		return PatternNone
+	}
+
+	between := valueBetween(values, a, b, pattern == PatternDoubleTop)
+	depthPct := math.Abs(values[a]-between) / math.Abs(values[a]) * 100
+	if depthPct < patternMinDepthPct {
+		return PatternNone
+	}
+
+	return pattern
+}
+
+// valueBetween returns the min (for tops) or max (for bottoms) value
+// strictly between indices a and b.
+func valueBetween(values []float64, a, b int, top bool) float64 {
+	if a > b {
+		a, b = b, a
+	}
+	extreme := values[a]
+	for i := a + 1; i < b; i++ {
+		if top && values[i] < extreme {
+			extreme = values[i]
+		}
+		if !top && values[i] > extreme {
+			extreme = values[i]
+		}
+	}
+	return extreme
+}
+
+// detectHeadShoulders looks for the classic three-peak shape among the
+// last three swing highs: a taller "head" flanked by two roughly-equal
+// "shoulders".
+func detectHeadShoulders(highs []float64, peaks []int) string {
+	if len(peaks) < 3 {
+		return PatternNone
+	}
+
+	last3 := peaks[len(peaks)-3:]
+	leftShoulder, head, rightShoulder := highs[last3[0]], highs[last3[1]], highs[last3[2]]
+
+	if head <= leftShoulder || head <= rightShoulder {
+		return PatternNone
+	}
+	if !roughlyEqual(leftShoulder, rightShoulder) {
+		return PatternNone
+	}
+
+	return PatternHeadShoulders
+}
+
+// detectTriangle looks for a flat side (resistance or support, within
+// tolerance) paired with a sloping opposite side, over the full window.
+func detectTriangle(highs, lows []float64) string {
+	highsFlat := roughlyEqual(highs[0], highs[len(highs)-1])
+	lowsFlat := roughlyEqual(lows[0], lows[len(lows)-1])
+
+	lowsRising := lows[len(lows)-1] > lows[0] && !lowsFlat
+	highsFalling := highs[len(highs)-1] < highs[0] && !highsFlat
+
+	if highsFlat && lowsRising {
+		return PatternAscendingTriangle
+	}
+	if lowsFlat && highsFalling {
+		return PatternDescendingTriangle
+	}
+
+	return PatternNone
+}
@@ -0,0 +1,48 @@
+package market
+
+const (
+	RegimeTrendingUp     = "trending_up"
+	RegimeTrendingDown   = "trending_down"
+	RegimeRanging        = "ranging"
+	RegimeHighVolatility = "high_volatility"
+
+	// adxTrendThreshold is the conventional ADX level above which a market
+	// is considered trending rather than ranging.
+	adxTrendThreshold = 25.0
+	// highVolATRPercent is the ATR-as-percent-of-price threshold above
+	// which a non-trending window is labeled high_volatility rather than
+	// ranging.
+	highVolATRPercent = 3.0
+)
+
+// ClassifyRegime labels the window as trending_up, trending_down, ranging,
+// or high_volatility using ADX (trend strength/direction) and ATR (choppy
+// vs. quiet) as deterministic ground truth, matching the rule-based
+// detectors used elsewhere in this package rather than a learned model.
+func ClassifyRegime(klines []Kline, period int) string {
+	if len(klines) == 0 {
+		return RegimeRanging
+	}
+
+	adx, plusDI, minusDI := CalculateADX(klines, period)
+	atr := CalculateATR(klines, period)
+	lastClose := klines[len(klines)-1].Close
+
+	var atrPct float64
+	if lastClose != 0 {
+		atrPct = atr / lastClose * 100
+	}
+
+	if adx >= adxTrendThreshold {
+		if plusDI >= minusDI {
+			return RegimeTrendingUp
+		}
+		return RegimeTrendingDown
+	}
+
+	if atrPct >= highVolATRPercent {
+		return RegimeHighVolatility
+	}
+
+	return RegimeRanging
+}
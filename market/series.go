@@ -0,0 +1,172 @@
+package market
+
+import "math"
+
+// IndicatorSeries is the full per-kline trajectory of an indicator,
+// aligned index-for-index with the klines it was computed from (index i
+// of Values corresponds to klines[i]; entries before an indicator has
+// enough warm-up data are 0).
+type IndicatorSeries struct {
+	Values []float64
+}
+
+// Last returns the value offset bars back from the most recent close:
+// offset=0 is the latest closed bar, offset=1 the one before it, and so
+// on, matching how trading strategies back-reference prior bar values
+// for exits and trend checks. Returns 0 if offset is out of range.
+func (s IndicatorSeries) Last(offset int) float64 {
+	idx := len(s.Values) - 1 - offset
+	if idx < 0 || idx >= len(s.Values) {
+		return 0
+	}
+	return s.Values[idx]
+}
+
+// CalculateSMASeries returns the SMA trajectory over klines.
+func CalculateSMASeries(klines []Kline, period int) IndicatorSeries {
+	values := make([]float64, len(klines))
+	for i := period - 1; i < len(klines); i++ {
+		values[i] = CalculateSMA(klines[:i+1], period)
+	}
+	return IndicatorSeries{Values: values}
+}
+
+// CalculateEMASeries returns the EMA trajectory over klines, seeded with
+// an SMA at index period-1 exactly like CalculateEMA.
+func CalculateEMASeries(klines []Kline, period int) IndicatorSeries {
+	values := make([]float64, len(klines))
+	if len(klines) < period {
+		return IndicatorSeries{Values: values}
+	}
+
+	sum := 0.0
+	for i := 0; i < period; i++ {
+		sum += klines[i].Close
+	}
+	ema := sum / float64(period)
+	values[period-1] = ema
+
+	multiplier := 2.0 / float64(period+1)
+	for i := period; i < len(klines); i++ {
+		ema = (klines[i].Close-ema)*multiplier + ema
+		values[i] = ema
+	}
+
+	return IndicatorSeries{Values: values}
+}
+
+// CalculateMACDSeries returns the EMA12-EMA26 trajectory over klines.
+func CalculateMACDSeries(klines []Kline) IndicatorSeries {
+	values := make([]float64, len(klines))
+	if len(klines) < 26 {
+		return IndicatorSeries{Values: values}
+	}
+
+	ema12 := CalculateEMASeries(klines, 12)
+	ema26 := CalculateEMASeries(klines, 26)
+	for i := 25; i < len(klines); i++ {
+		values[i] = ema12.Values[i] - ema26.Values[i]
+	}
+
+	return IndicatorSeries{Values: values}
+}
+
+// CalculateRSISeries returns the Wilder-smoothed RSI trajectory over klines.
+func CalculateRSISeries(klines []Kline, period int) IndicatorSeries {
+	values := make([]float64, len(klines))
+	if len(klines) <= period {
+		return IndicatorSeries{Values: values}
+	}
+
+	gains, losses := 0.0, 0.0
+	for i := 1; i <= period; i++ {
+		change := klines[i].Close - klines[i-1].Close
+		if change > 0 {
+			gains += change
+		} else {
+			losses += -change
+		}
+	}
+	avgGain := gains / float64(period)
+	avgLoss := losses / float64(period)
+	values[period] = rsiFromAverages(avgGain, avgLoss)
+
+	for i := period + 1; i < len(klines); i++ {
+		change := klines[i].Close - klines[i-1].Close
+		if change > 0 {
+			avgGain = (avgGain*float64(period-1) + change) / float64(period)
+			avgLoss = (avgLoss * float64(period-1)) / float64(period)
+		} else {
+			avgGain = (avgGain * float64(period-1)) / float64(period)
+			avgLoss = (avgLoss*float64(period-1) + (-change)) / float64(period)
+		}
+		values[i] = rsiFromAverages(avgGain, avgLoss)
+	}
+
+	return IndicatorSeries{Values: values}
+}
+
+func rsiFromAverages(avgGain, avgLoss float64) float64 {
+	if avgLoss == 0 {
+		return 100
+	}
+	rs := avgGain / avgLoss
+	return 100 - (100 / (1 + rs))
+}
+
+// CalculateATRSeries returns the Wilder-smoothed ATR trajectory over klines.
+func CalculateATRSeries(klines []Kline, period int) IndicatorSeries {
+	values := make([]float64, len(klines))
+	if len(klines) <= period {
+		return IndicatorSeries{Values: values}
+	}
+
+	trs := make([]float64, len(klines))
+	for i := 1; i < len(klines); i++ {
+		high, low := klines[i].High, klines[i].Low
+		prevClose := klines[i-1].Close
+		tr1 := high - low
+		tr2 := math.Abs(high - prevClose)
+		tr3 := math.Abs(low - prevClose)
+		trs[i] = math.Max(tr1, math.Max(tr2, tr3))
+	}
+
+	sum := 0.0
+	for i := 1; i <= period; i++ {
+		sum += trs[i]
+	}
+	atr := sum / float64(period)
+	values[period] = atr
+
+	for i := period + 1; i < len(klines); i++ {
+		atr = (atr*float64(period-1) + trs[i]) / float64(period)
+		values[i] = atr
+	}
+
+	return IndicatorSeries{Values: values}
+}
+
+// CalculateBOLLSeries returns the upper/middle/lower Bollinger Band
+// trajectories over klines.
+func CalculateBOLLSeries(klines []Kline, period int, multiplier float64) (upper, middle, lower IndicatorSeries) {
+	upperValues := make([]float64, len(klines))
+	middleValues := make([]float64, len(klines))
+	lowerValues := make([]float64, len(klines))
+
+	for i := period - 1; i < len(klines); i++ {
+		u, m, l := CalculateBOLL(klines[:i+1], period, multiplier)
+		upperValues[i], middleValues[i], lowerValues[i] = u, m, l
+	}
+
+	return IndicatorSeries{Values: upperValues}, IndicatorSeries{Values: middleValues}, IndicatorSeries{Values: lowerValues}
+}
+
+// CalculateVolumeMASeries returns the volume moving-average trajectory
+// over klines.
+func CalculateVolumeMASeries(klines []Kline, period int) IndicatorSeries {
+	values := make([]float64, len(klines))
+	for i := period - 1; i < len(klines); i++ {
+		values[i] = CalculateVolumeMA(klines[:i+1], period)
+	}
+	return IndicatorSeries{Values: values}
+}
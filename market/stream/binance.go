@@ -0,0 +1,123 @@
+package stream
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"FinBench/market"
+)
+
+// binanceSpotWSBaseURL/binanceFuturesWSBaseURL are vars rather than
+// consts so tests can point a BinanceSource at a fake WebSocket server.
+var (
+	binanceSpotWSBaseURL    = "wss://stream.binance.com:9443/stream"
+	binanceFuturesWSBaseURL = "wss://fstream.binance.com/stream"
+)
+
+// BinanceSource streams kline updates from Binance's combined-stream
+// WebSocket endpoint, for either spot or USD-M futures depending on
+// Futures.
+type BinanceSource struct {
+	Futures bool
+	cancel  context.CancelFunc
+}
+
+// NewBinanceSource creates a BinanceSource. Set futures to subscribe to
+// the USD-M futures feed instead of spot.
+func NewBinanceSource(futures bool) *BinanceSource {
+	return &BinanceSource{Futures: futures}
+}
+
+func (s *BinanceSource) Name() string {
+	if s.Futures {
+		return "binance-futures"
+	}
+	return "binance"
+}
+
+func (s *BinanceSource) Subscribe(ctx context.Context, symbols []string, interval string) (<-chan Bar, error) {
+	streamCtx, cancel := context.WithCancel(ctx)
+	s.cancel = cancel
+
+	streams := make([]string, len(symbols))
+	for i, sym := range symbols {
+		streams[i] = fmt.Sprintf("%s@kline_%s", strings.ToLower(sym), interval)
+	}
+
+	base := binanceSpotWSBaseURL
+	if s.Futures {
+		base = binanceFuturesWSBaseURL
+	}
+	url := fmt.Sprintf("%s?streams=%s", base, strings.Join(streams, "/"))
+
+	out := make(chan Bar)
+	go runConn(streamCtx, s.Name(), url, nil, parseBinanceMessage, out)
+
+	return out, nil
+}
+
+func (s *BinanceSource) Close() error {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	return nil
+}
+
+// binanceKlineEvent is Binance's combined-stream kline push message.
+type binanceKlineEvent struct {
+	Stream string `json:"stream"`
+	Data   struct {
+		EventType string `json:"e"`
+		Symbol    string `json:"s"`
+		Kline     struct {
+			Interval  string `json:"i"`
+			Open      string `json:"o"`
+			High      string `json:"h"`
+			Low       string `json:"l"`
+			Close     string `json:"c"`
+			Volume    string `json:"v"`
+			OpenTime  int64  `json:"t"`
+			CloseTime int64  `json:"T"`
+			Closed    bool   `json:"x"`
+		} `json:"k"`
+	} `json:"data"`
+}
+
+func parseBinanceMessage(data []byte) (Bar, bool, error) {
+	var event binanceKlineEvent
+	if err := json.Unmarshal(data, &event); err != nil {
+		return Bar{}, false, fmt.Errorf("unmarshal binance event: %w", err)
+	}
+	if event.Data.EventType != "kline" {
+		return Bar{}, false, nil
+	}
+
+	k := event.Data.Kline
+	open, err := strconv.ParseFloat(k.Open, 64)
+	if err != nil {
+		return Bar{}, false, fmt.Errorf("parse open: %w", err)
+	}
+	high, _ := strconv.ParseFloat(k.High, 64)
+	low, _ := strconv.ParseFloat(k.Low, 64)
+	closePrice, _ := strconv.ParseFloat(k.Close, 64)
+	volume, _ := strconv.ParseFloat(k.Volume, 64)
+
+	bar := Bar{
+		Symbol:   event.Data.Symbol,
+		Interval: k.Interval,
+		Closed:   k.Closed,
+		Kline: market.Kline{
+			OpenTime:  k.OpenTime,
+			CloseTime: k.CloseTime,
+			Open:      open,
+			High:      high,
+			Low:       low,
+			Close:     closePrice,
+			Volume:    volume,
+		},
+	}
+	return bar, true, nil
+}
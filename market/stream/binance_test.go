@@ -0,0 +1,66 @@
+package stream
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+func TestParseBinanceMessage(t *testing.T) {
+	msg := `{"stream":"btcusdt@kline_1m","data":{"e":"kline","s":"BTCUSDT","k":{"i":"1m","o":"100.0","h":"101.0","l":"99.0","c":"100.5","v":"10.0","t":1000,"T":2000,"x":true}}}`
+
+	bar, ok, err := parseBinanceMessage([]byte(msg))
+	if err != nil {
+		t.Fatalf("parseBinanceMessage error: %v", err)
+	}
+	if !ok {
+		t.Fatal("parseBinanceMessage returned ok=false for a kline event")
+	}
+	if bar.Symbol != "BTCUSDT" || !bar.Closed || bar.Kline.Close != 100.5 {
+		t.Errorf("unexpected bar: %+v", bar)
+	}
+}
+
+func TestParseBinanceMessage_NonKlineEvent(t *testing.T) {
+	_, ok, err := parseBinanceMessage([]byte(`{"stream":"btcusdt@kline_1m","data":{"e":"somethingElse"}}`))
+	if err != nil {
+		t.Fatalf("parseBinanceMessage error: %v", err)
+	}
+	if ok {
+		t.Fatal("parseBinanceMessage returned ok=true for a non-kline event")
+	}
+}
+
+func TestBinanceSource_Subscribe(t *testing.T) {
+	const msg = `{"stream":"btcusdt@kline_1m","data":{"e":"kline","s":"BTCUSDT","k":{"i":"1m","o":"100.0","h":"101.0","l":"99.0","c":"100.5","v":"10.0","t":1000,"T":2000,"x":true}}}`
+
+	srv, url := newFakeWSServer(t, func(conn *websocket.Conn) {
+		_ = conn.WriteMessage(websocket.TextMessage, []byte(msg))
+		time.Sleep(100 * time.Millisecond)
+	})
+	defer srv.Close()
+
+	orig := binanceSpotWSBaseURL
+	binanceSpotWSBaseURL = url
+	defer func() { binanceSpotWSBaseURL = orig }()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	src := NewBinanceSource(false)
+	out, err := src.Subscribe(ctx, []string{"BTCUSDT"}, "1m")
+	if err != nil {
+		t.Fatalf("Subscribe error: %v", err)
+	}
+
+	select {
+	case bar := <-out:
+		if bar.Symbol != "BTCUSDT" || !bar.Closed {
+			t.Errorf("unexpected bar: %+v", bar)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for a bar from BinanceSource")
+	}
+}
@@ -0,0 +1,113 @@
+package stream
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"FinBench/market"
+)
+
+// coinbaseWSBaseURL is a var rather than a const so tests can point a
+// CoinbaseSource at a fake WebSocket server.
+var coinbaseWSBaseURL = "wss://advanced-trade-ws.coinbase.com"
+
+// CoinbaseSource streams candle updates from Coinbase's Advanced Trade
+// WebSocket API.
+type CoinbaseSource struct {
+	cancel context.CancelFunc
+}
+
+// NewCoinbaseSource creates a CoinbaseSource.
+func NewCoinbaseSource() *CoinbaseSource {
+	return &CoinbaseSource{}
+}
+
+func (s *CoinbaseSource) Name() string { return "coinbase" }
+
+type coinbaseSubscribeRequest struct {
+	Type       string   `json:"type"`
+	Channel    string   `json:"channel"`
+	ProductIDs []string `json:"product_ids"`
+}
+
+func (s *CoinbaseSource) Subscribe(ctx context.Context, symbols []string, interval string) (<-chan Bar, error) {
+	streamCtx, cancel := context.WithCancel(ctx)
+	s.cancel = cancel
+
+	subscribeMsg, err := json.Marshal(coinbaseSubscribeRequest{
+		Type:       "subscribe",
+		Channel:    "candles",
+		ProductIDs: symbols,
+	})
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("marshal coinbase subscribe request: %w", err)
+	}
+
+	out := make(chan Bar)
+	go runConn(streamCtx, s.Name(), coinbaseWSBaseURL, [][]byte{subscribeMsg}, parseCoinbaseMessage, out)
+
+	return out, nil
+}
+
+func (s *CoinbaseSource) Close() error {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	return nil
+}
+
+// coinbaseCandlesMessage is Coinbase's "candles" channel event envelope.
+type coinbaseCandlesMessage struct {
+	Channel string `json:"channel"`
+	Events  []struct {
+		Candles []struct {
+			Start     string `json:"start"`
+			Open      string `json:"open"`
+			High      string `json:"high"`
+			Low       string `json:"low"`
+			Close     string `json:"close"`
+			Volume    string `json:"volume"`
+			ProductID string `json:"product_id"`
+		} `json:"candles"`
+	} `json:"events"`
+}
+
+func parseCoinbaseMessage(data []byte) (Bar, bool, error) {
+	var msg coinbaseCandlesMessage
+	if err := json.Unmarshal(data, &msg); err != nil {
+		return Bar{}, false, fmt.Errorf("unmarshal coinbase message: %w", err)
+	}
+	if msg.Channel != "candles" || len(msg.Events) == 0 || len(msg.Events[0].Candles) == 0 {
+		return Bar{}, false, nil
+	}
+
+	c := msg.Events[0].Candles[0]
+	start, err := strconv.ParseInt(c.Start, 10, 64)
+	if err != nil {
+		return Bar{}, false, fmt.Errorf("parse start: %w", err)
+	}
+	open, _ := strconv.ParseFloat(c.Open, 64)
+	high, _ := strconv.ParseFloat(c.High, 64)
+	low, _ := strconv.ParseFloat(c.Low, 64)
+	closePrice, _ := strconv.ParseFloat(c.Close, 64)
+	volume, _ := strconv.ParseFloat(c.Volume, 64)
+
+	// The Advanced Trade candles channel only pushes completed bars, so
+	// every update this parses is closed.
+	bar := Bar{
+		Symbol: c.ProductID,
+		Closed: true,
+		Kline: market.Kline{
+			OpenTime: start * 1000,
+			Open:     open,
+			High:     high,
+			Low:      low,
+			Close:    closePrice,
+			Volume:   volume,
+		},
+	}
+	return bar, true, nil
+}
@@ -0,0 +1,66 @@
+package stream
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+func TestParseCoinbaseMessage(t *testing.T) {
+	msg := `{"channel":"candles","events":[{"candles":[{"start":"1000","open":"100.0","high":"101.0","low":"99.0","close":"100.5","volume":"10.0","product_id":"BTC-USD"}]}]}`
+
+	bar, ok, err := parseCoinbaseMessage([]byte(msg))
+	if err != nil {
+		t.Fatalf("parseCoinbaseMessage error: %v", err)
+	}
+	if !ok {
+		t.Fatal("parseCoinbaseMessage returned ok=false for a candles event")
+	}
+	if bar.Symbol != "BTC-USD" || !bar.Closed || bar.Kline.Close != 100.5 {
+		t.Errorf("unexpected bar: %+v", bar)
+	}
+}
+
+func TestParseCoinbaseMessage_OtherChannel(t *testing.T) {
+	_, ok, err := parseCoinbaseMessage([]byte(`{"channel":"heartbeats"}`))
+	if err != nil {
+		t.Fatalf("parseCoinbaseMessage error: %v", err)
+	}
+	if ok {
+		t.Fatal("parseCoinbaseMessage returned ok=true for a non-candles channel")
+	}
+}
+
+func TestCoinbaseSource_Subscribe(t *testing.T) {
+	const msg = `{"channel":"candles","events":[{"candles":[{"start":"1000","open":"100.0","high":"101.0","low":"99.0","close":"100.5","volume":"10.0","product_id":"BTC-USD"}]}]}`
+
+	srv, url := newFakeWSServer(t, func(conn *websocket.Conn) {
+		_ = conn.WriteMessage(websocket.TextMessage, []byte(msg))
+		time.Sleep(100 * time.Millisecond)
+	})
+	defer srv.Close()
+
+	orig := coinbaseWSBaseURL
+	coinbaseWSBaseURL = url
+	defer func() { coinbaseWSBaseURL = orig }()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	src := NewCoinbaseSource()
+	out, err := src.Subscribe(ctx, []string{"BTC-USD"}, "1m")
+	if err != nil {
+		t.Fatalf("Subscribe error: %v", err)
+	}
+
+	select {
+	case bar := <-out:
+		if bar.Symbol != "BTC-USD" || !bar.Closed {
+			t.Errorf("unexpected bar: %+v", bar)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for a bar from CoinbaseSource")
+	}
+}
@@ -0,0 +1,119 @@
+package stream
+
+import (
+	"context"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"FinBench/logger"
+)
+
+const (
+	reconnectBaseDelay = 1 * time.Second
+	reconnectMaxDelay  = 30 * time.Second
+)
+
+// parseFunc decodes one raw WebSocket message into a Bar. ok is false for
+// messages that aren't bar updates (acks, pings, heartbeats) and should be
+// silently skipped rather than treated as a parse error.
+type parseFunc func(data []byte) (bar Bar, ok bool, err error)
+
+// runConn is the shared connection loop behind every StreamSource: dial,
+// send any subscribe handshake messages, read and decode frames until the
+// connection drops, then reconnect with exponential backoff. It owns
+// nothing exchange-specific beyond the URL, handshake payloads, and a
+// decoder, mirroring how modern market-data streaming clients separate
+// "stay connected" from "understand this exchange's wire format".
+func runConn(ctx context.Context, name, url string, subscribeMsgs [][]byte, parse parseFunc, out chan<- Bar) {
+	defer close(out)
+
+	attempt := 0
+	for {
+		if ctx.Err() != nil {
+			return
+		}
+
+		conn, _, err := websocket.DefaultDialer.DialContext(ctx, url, nil)
+		if err != nil {
+			logger.Warnf("%s stream: dial failed: %v", name, err)
+			if !sleepOrDone(ctx, backoff(attempt, reconnectBaseDelay, reconnectMaxDelay)) {
+				return
+			}
+			attempt++
+			continue
+		}
+
+		if err := sendAll(conn, subscribeMsgs); err != nil {
+			logger.Warnf("%s stream: subscribe failed: %v", name, err)
+			conn.Close()
+			if !sleepOrDone(ctx, backoff(attempt, reconnectBaseDelay, reconnectMaxDelay)) {
+				return
+			}
+			attempt++
+			continue
+		}
+
+		logger.Infof("%s stream: connected", name)
+		attempt = 0
+
+		if err := readLoop(ctx, conn, parse, out); err != nil {
+			logger.Warnf("%s stream: connection lost: %v", name, err)
+		}
+		conn.Close()
+
+		if !sleepOrDone(ctx, backoff(attempt, reconnectBaseDelay, reconnectMaxDelay)) {
+			return
+		}
+		attempt++
+	}
+}
+
+func sendAll(conn *websocket.Conn, msgs [][]byte) error {
+	for _, msg := range msgs {
+		if err := conn.WriteMessage(websocket.TextMessage, msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func readLoop(ctx context.Context, conn *websocket.Conn, parse parseFunc, out chan<- Bar) error {
+	for {
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		_, data, err := conn.ReadMessage()
+		if err != nil {
+			return err
+		}
+
+		bar, ok, err := parse(data)
+		if err != nil {
+			logger.Warnf("stream: decode message failed: %v", err)
+			continue
+		}
+		if !ok {
+			continue
+		}
+
+		select {
+		case out <- bar:
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// sleepOrDone waits for d, returning false if ctx is cancelled first.
+func sleepOrDone(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
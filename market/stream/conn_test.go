@@ -0,0 +1,67 @@
+package stream
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+func TestBackoff(t *testing.T) {
+	base := time.Second
+	max := 30 * time.Second
+
+	cases := []struct {
+		attempt int
+		want    time.Duration
+	}{
+		{0, base},
+		{1, 2 * time.Second},
+		{2, 4 * time.Second},
+		{10, max}, // capped
+	}
+
+	for _, c := range cases {
+		if got := backoff(c.attempt, base, max); got != c.want {
+			t.Errorf("backoff(%d, ...) = %v, want %v", c.attempt, got, c.want)
+		}
+	}
+}
+
+// TestRunConnReconnectsAfterDrop simulates a server that drops the first
+// connection immediately and only serves data on the second, verifying
+// runConn's reconnect-with-backoff path recovers instead of giving up.
+func TestRunConnReconnectsAfterDrop(t *testing.T) {
+	const binanceKlineMsg = `{"stream":"btcusdt@kline_1m","data":{"e":"kline","s":"BTCUSDT","k":{"i":"1m","o":"100.0","h":"101.0","l":"99.0","c":"100.5","v":"10.0","t":1000,"T":2000,"x":true}}}`
+
+	var attempts int32
+	srv, url := newFakeWSServer(t, func(conn *websocket.Conn) {
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			return // drop the first connection without sending anything
+		}
+		_ = conn.WriteMessage(websocket.TextMessage, []byte(binanceKlineMsg))
+		time.Sleep(200 * time.Millisecond)
+	})
+	defer srv.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	out := make(chan Bar)
+	go runConn(ctx, "test", url, nil, parseBinanceMessage, out)
+
+	select {
+	case bar := <-out:
+		if bar.Symbol != "BTCUSDT" {
+			t.Errorf("bar.Symbol = %q, want BTCUSDT", bar.Symbol)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("runConn never delivered a bar after reconnecting")
+	}
+
+	if n := atomic.LoadInt32(&attempts); n < 2 {
+		t.Errorf("expected at least 2 connection attempts, got %d", n)
+	}
+}
@@ -0,0 +1,142 @@
+package stream
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"FinBench/market"
+)
+
+// okxWSBaseURL is a var rather than a const so tests can point an
+// OKXSource at a fake WebSocket server.
+var okxWSBaseURL = "wss://ws.okx.com:8443/ws/v5/business"
+
+// OKXSource streams candlestick updates from OKX's public WebSocket API.
+type OKXSource struct {
+	cancel context.CancelFunc
+}
+
+// NewOKXSource creates an OKXSource.
+func NewOKXSource() *OKXSource {
+	return &OKXSource{}
+}
+
+func (s *OKXSource) Name() string { return "okx" }
+
+type okxSubscribeRequest struct {
+	Op   string          `json:"op"`
+	Args []okxChannelArg `json:"args"`
+}
+
+type okxChannelArg struct {
+	Channel string `json:"channel"`
+	InstID  string `json:"instId"`
+}
+
+func (s *OKXSource) Subscribe(ctx context.Context, symbols []string, interval string) (<-chan Bar, error) {
+	streamCtx, cancel := context.WithCancel(ctx)
+	s.cancel = cancel
+
+	channel := "candle" + okxInterval(interval)
+	args := make([]okxChannelArg, len(symbols))
+	for i, sym := range symbols {
+		args[i] = okxChannelArg{Channel: channel, InstID: sym}
+	}
+
+	subscribeMsg, err := json.Marshal(okxSubscribeRequest{Op: "subscribe", Args: args})
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("marshal okx subscribe request: %w", err)
+	}
+
+	out := make(chan Bar)
+	go runConn(streamCtx, s.Name(), okxWSBaseURL, [][]byte{subscribeMsg}, parseOKXMessage, out)
+
+	return out, nil
+}
+
+func (s *OKXSource) Close() error {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	return nil
+}
+
+// okxIntervalSuffixes maps FinBench's lowercase interval strings (see
+// market/kline.go's parseInterval) onto OKX's candle channel suffixes,
+// which are minute-granularity lowercase ("1m", "5m", ...) but require
+// uppercase H/D/W/M for hour/day/week/month buckets ("1H", "4H", "1D",
+// "1W"); subscribing with the wrong case asks for a channel that simply
+// doesn't exist, so the stream would hang with zero bars forever.
+var okxIntervalSuffixes = map[string]string{
+	"1m": "1m", "3m": "3m", "5m": "5m", "15m": "15m", "30m": "30m",
+	"1h": "1H", "2h": "2H", "4h": "4H", "6h": "6H", "8h": "8H", "12h": "12H",
+	"1d": "1D", "3d": "3D", "1w": "1W",
+}
+
+// okxInterval maps a FinBench interval string onto its OKX candle channel
+// suffix, falling back to the input unchanged if it's not recognized.
+func okxInterval(interval string) string {
+	if suffix, ok := okxIntervalSuffixes[interval]; ok {
+		return suffix
+	}
+	return interval
+}
+
+// okxCandleMessage is OKX's candle channel push message: data rows are
+// [ts, open, high, low, close, volume, volCcy, volCcyQuote, confirm].
+// Event/Code/Msg are populated instead of Arg/Data for subscribe
+// acknowledgements and error responses (e.g. subscribing to a channel
+// name OKX doesn't recognize).
+type okxCandleMessage struct {
+	Event string        `json:"event"`
+	Code  string        `json:"code"`
+	Msg   string        `json:"msg"`
+	Arg   okxChannelArg `json:"arg"`
+	Data  [][]string    `json:"data"`
+}
+
+func parseOKXMessage(data []byte) (Bar, bool, error) {
+	var msg okxCandleMessage
+	if err := json.Unmarshal(data, &msg); err != nil {
+		return Bar{}, false, fmt.Errorf("unmarshal okx message: %w", err)
+	}
+	if msg.Event == "error" {
+		return Bar{}, false, fmt.Errorf("okx error event: code=%s msg=%s", msg.Code, msg.Msg)
+	}
+	if msg.Event != "" {
+		// e.g. a "subscribe" acknowledgement: not an error, but no bar.
+		return Bar{}, false, nil
+	}
+	if len(msg.Data) == 0 || len(msg.Data[0]) < 9 {
+		return Bar{}, false, nil
+	}
+
+	row := msg.Data[0]
+	ts, err := strconv.ParseInt(row[0], 10, 64)
+	if err != nil {
+		return Bar{}, false, fmt.Errorf("parse ts: %w", err)
+	}
+	open, _ := strconv.ParseFloat(row[1], 64)
+	high, _ := strconv.ParseFloat(row[2], 64)
+	low, _ := strconv.ParseFloat(row[3], 64)
+	closePrice, _ := strconv.ParseFloat(row[4], 64)
+	volume, _ := strconv.ParseFloat(row[5], 64)
+	closed := row[8] == "1"
+
+	bar := Bar{
+		Symbol: msg.Arg.InstID,
+		Closed: closed,
+		Kline: market.Kline{
+			OpenTime: ts,
+			Open:     open,
+			High:     high,
+			Low:      low,
+			Close:    closePrice,
+			Volume:   volume,
+		},
+	}
+	return bar, true, nil
+}
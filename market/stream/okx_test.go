@@ -0,0 +1,106 @@
+package stream
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+func TestOkxInterval(t *testing.T) {
+	cases := map[string]string{
+		"1m": "1m", "5m": "5m", "30m": "30m",
+		"1h": "1H", "4h": "4H", "12h": "12H",
+		"1d": "1D", "3d": "3D", "1w": "1W",
+	}
+	for in, want := range cases {
+		if got := okxInterval(in); got != want {
+			t.Errorf("okxInterval(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestParseOKXMessage_Candle(t *testing.T) {
+	msg := `{"arg":{"channel":"candle1H","instId":"BTC-USDT"},"data":[["1000","100.0","101.0","99.0","100.5","10.0","1000","1000","1"]]}`
+
+	bar, ok, err := parseOKXMessage([]byte(msg))
+	if err != nil {
+		t.Fatalf("parseOKXMessage error: %v", err)
+	}
+	if !ok {
+		t.Fatal("parseOKXMessage returned ok=false for a candle push")
+	}
+	if bar.Symbol != "BTC-USDT" || !bar.Closed || bar.Kline.Close != 100.5 {
+		t.Errorf("unexpected bar: %+v", bar)
+	}
+}
+
+func TestParseOKXMessage_SubscribeAck(t *testing.T) {
+	_, ok, err := parseOKXMessage([]byte(`{"event":"subscribe","arg":{"channel":"candle1H","instId":"BTC-USDT"}}`))
+	if err != nil {
+		t.Fatalf("parseOKXMessage returned an error for a subscribe ack: %v", err)
+	}
+	if ok {
+		t.Fatal("parseOKXMessage returned ok=true for a subscribe ack")
+	}
+}
+
+func TestParseOKXMessage_ErrorEvent(t *testing.T) {
+	_, ok, err := parseOKXMessage([]byte(`{"event":"error","code":"60012","msg":"Invalid request: candle1h doesn't exist"}`))
+	if err == nil {
+		t.Fatal("parseOKXMessage returned no error for an OKX error event")
+	}
+	if ok {
+		t.Fatal("parseOKXMessage returned ok=true for an OKX error event")
+	}
+	if !strings.Contains(err.Error(), "60012") {
+		t.Errorf("error %q doesn't mention the OKX error code", err)
+	}
+}
+
+func TestOKXSource_Subscribe(t *testing.T) {
+	const push = `{"arg":{"channel":"candle1H","instId":"BTC-USDT"},"data":[["1000","100.0","101.0","99.0","100.5","10.0","1000","1000","1"]]}`
+
+	received := make(chan string, 1)
+	srv, url := newFakeWSServer(t, func(conn *websocket.Conn) {
+		if _, data, err := conn.ReadMessage(); err == nil {
+			received <- string(data)
+		}
+		_ = conn.WriteMessage(websocket.TextMessage, []byte(push))
+		time.Sleep(100 * time.Millisecond)
+	})
+	defer srv.Close()
+
+	orig := okxWSBaseURL
+	okxWSBaseURL = url
+	defer func() { okxWSBaseURL = orig }()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	src := NewOKXSource()
+	out, err := src.Subscribe(ctx, []string{"BTC-USDT"}, "1h")
+	if err != nil {
+		t.Fatalf("Subscribe error: %v", err)
+	}
+
+	select {
+	case sub := <-received:
+		if !strings.Contains(sub, `"channel":"candle1H"`) {
+			t.Errorf("subscribe request didn't use the uppercase OKX channel name: %s", sub)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("server never received a subscribe request")
+	}
+
+	select {
+	case bar := <-out:
+		if bar.Symbol != "BTC-USDT" || !bar.Closed {
+			t.Errorf("unexpected bar: %+v", bar)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("timed out waiting for a bar from OKXSource")
+	}
+}
@@ -0,0 +1,73 @@
+// Package stream subscribes to exchange WebSocket kline feeds and emits
+// closed bars as they arrive, for FinBench's realtime benchmark mode.
+package stream
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"FinBench/market"
+)
+
+// Bar is one kline update pushed by a StreamSource. Closed is false for
+// the still-forming current bar (most exchanges push in-progress updates
+// too) and true once the bar's interval has elapsed.
+type Bar struct {
+	Symbol   string
+	Interval string
+	Kline    market.Kline
+	Closed   bool
+}
+
+// StreamSource is implemented by each exchange's WebSocket client. It
+// owns its own connect/subscribe handshake, message decoding, and
+// reconnect-with-backoff, so the benchmark runner only has to read Bar
+// values off the returned channel.
+type StreamSource interface {
+	// Name identifies the exchange (e.g. "binance", "okx", "coinbase").
+	Name() string
+	// Subscribe opens the feed for the given symbols/interval and returns
+	// a channel of Bar updates. The channel is closed when ctx is
+	// cancelled or Close is called.
+	Subscribe(ctx context.Context, symbols []string, interval string) (<-chan Bar, error)
+	// Close releases the underlying connection.
+	Close() error
+}
+
+// backoff returns the reconnect delay for the given consecutive-failure
+// count, doubling from baseDelay up to maxDelay.
+func backoff(attempt int, baseDelay, maxDelay time.Duration) time.Duration {
+	if attempt <= 0 {
+		return baseDelay
+	}
+	delay := float64(baseDelay) * math.Pow(2, float64(attempt))
+	if delay > float64(maxDelay) {
+		return maxDelay
+	}
+	return time.Duration(delay)
+}
+
+// RollingBuffer keeps the last `capacity` closed klines per symbol, the
+// minimum a benchmark snapshot needs to recompute indicators.
+type RollingBuffer struct {
+	capacity int
+	bars     map[string][]market.Kline
+}
+
+// NewRollingBuffer creates a RollingBuffer holding up to capacity bars per
+// symbol.
+func NewRollingBuffer(capacity int) *RollingBuffer {
+	return &RollingBuffer{capacity: capacity, bars: make(map[string][]market.Kline)}
+}
+
+// Push appends a closed kline for symbol, trimming to capacity, and
+// reports whether the buffer now has enough bars to form a snapshot.
+func (b *RollingBuffer) Push(symbol string, k market.Kline) (klines []market.Kline, ready bool) {
+	bars := append(b.bars[symbol], k)
+	if len(bars) > b.capacity {
+		bars = bars[len(bars)-b.capacity:]
+	}
+	b.bars[symbol] = bars
+	return bars, len(bars) >= b.capacity
+}
@@ -0,0 +1,34 @@
+package stream
+
+import (
+	"testing"
+
+	"FinBench/market"
+)
+
+func TestRollingBuffer_Push(t *testing.T) {
+	buf := NewRollingBuffer(3)
+
+	for i := 0; i < 2; i++ {
+		klines, ready := buf.Push("BTCUSDT", market.Kline{OpenTime: int64(i)})
+		if ready {
+			t.Fatalf("ready=true after %d bars, want false before capacity is reached", len(klines))
+		}
+	}
+
+	klines, ready := buf.Push("BTCUSDT", market.Kline{OpenTime: 2})
+	if !ready {
+		t.Fatal("ready=false after reaching capacity")
+	}
+	if len(klines) != 3 {
+		t.Fatalf("len(klines) = %d, want 3", len(klines))
+	}
+
+	klines, ready = buf.Push("BTCUSDT", market.Kline{OpenTime: 3})
+	if !ready {
+		t.Fatal("ready=false once over capacity")
+	}
+	if len(klines) != 3 || klines[0].OpenTime != 1 {
+		t.Fatalf("buffer didn't trim to the last 3 bars: %+v", klines)
+	}
+}
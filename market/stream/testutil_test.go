@@ -0,0 +1,31 @@
+package stream
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+)
+
+var testUpgrader = websocket.Upgrader{}
+
+// newFakeWSServer starts an httptest server that upgrades every incoming
+// request to a WebSocket and hands the connection to handler, returning
+// the server (callers must defer srv.Close()) and its ws:// URL.
+func newFakeWSServer(t *testing.T, handler func(conn *websocket.Conn)) (*httptest.Server, string) {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := testUpgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		handler(conn)
+	}))
+
+	url := "ws" + strings.TrimPrefix(srv.URL, "http")
+	return srv, url
+}